@@ -0,0 +1,14 @@
+# The latest commit on main that touched the orders table
+data "lakefs_commit_log" "orders" {
+  repository = "my-repo"
+  ref        = "main"
+  prefixes   = ["tables/orders/"]
+  amount     = 1
+}
+
+# Pin it with a tag for a reproducible model release
+resource "lakefs_tag" "model_release" {
+  repository = "my-repo"
+  id         = "model-v1.4.0"
+  ref        = data.lakefs_commit_log.orders.commits[0].id
+}
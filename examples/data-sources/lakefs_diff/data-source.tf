@@ -0,0 +1,21 @@
+# What merging staging into main would remove from the orders table
+data "lakefs_diff" "promotion" {
+  repository = "my-repo"
+  left_ref   = "main"
+  right_ref  = "staging"
+  prefix     = "tables/orders/"
+  types      = ["removed"]
+}
+
+resource "lakefs_tag" "release" {
+  repository = "my-repo"
+  id         = "release-2024-06"
+  ref        = "staging"
+
+  lifecycle {
+    precondition {
+      condition     = data.lakefs_diff.promotion.summary.removed <= 100
+      error_message = "Promotion would delete more than 100 objects under tables/orders/."
+    }
+  }
+}
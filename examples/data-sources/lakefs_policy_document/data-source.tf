@@ -0,0 +1,20 @@
+data "lakefs_policy_document" "read_write" {
+  for_each = toset(["analytics", "ml-features"])
+
+  statement {
+    action   = ["fs:ReadRepository", "fs:ReadObject", "fs:ListObjects"]
+    resource = "arn:lakefs:fs:::repository/${each.key}"
+  }
+
+  statement {
+    action   = ["fs:WriteObject", "fs:DeleteObject"]
+    resource = "arn:lakefs:fs:::repository/${each.key}/object/*"
+  }
+}
+
+resource "lakefs_policy" "read_write" {
+  for_each = data.lakefs_policy_document.read_write
+
+  id        = "${each.key}-read-write"
+  statement = each.value.json
+}
@@ -0,0 +1,18 @@
+variable "ref" {
+  description = "A branch, tag, commit ID or ref expression such as main~1"
+  type        = string
+  default     = "main"
+}
+
+data "lakefs_ref" "input" {
+  repository = "my-repo"
+  ref        = var.ref
+}
+
+output "resolved" {
+  value = {
+    kind      = data.lakefs_ref.input.kind
+    commit_id = data.lakefs_ref.input.commit_id
+    message   = data.lakefs_ref.input.message
+  }
+}
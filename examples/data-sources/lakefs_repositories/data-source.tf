@@ -0,0 +1,11 @@
+data "lakefs_repositories" "all" {}
+
+# Apply uniform branch protection to every repository
+resource "lakefs_branch_protection" "main" {
+  for_each = { for repo in data.lakefs_repositories.all.repositories : repo.id => repo }
+
+  repository = each.key
+  rules = [
+    { pattern = each.value.default_branch }
+  ]
+}
@@ -0,0 +1,12 @@
+data "lakefs_storages" "all" {}
+
+# Place a repository on a specific storage of a multi-storage installation
+resource "lakefs_repository" "archive" {
+  name              = "archive"
+  storage_id        = "archive"
+  storage_namespace = "gs://archive-bucket/lakefs/archive"
+}
+
+output "storage_ids" {
+  value = data.lakefs_storages.all.storages[*].id
+}
@@ -0,0 +1,11 @@
+data "lakefs_users" "all" {}
+
+# Access review: which policies apply to each user, directly or through groups
+output "effective_policies" {
+  value = { for user in data.lakefs_users.all.users : user.id => user.effective_policies }
+}
+
+# Users without any group membership
+output "ungrouped_users" {
+  value = [for user in data.lakefs_users.all.users : user.id if length(user.groups) == 0]
+}
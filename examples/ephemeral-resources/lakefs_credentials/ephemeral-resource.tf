@@ -0,0 +1,14 @@
+ephemeral "lakefs_credentials" "ci" {
+  user_id = "ci-runner"
+}
+
+# The key pair only exists for the duration of the run and never lands in state
+provider "aws" {
+  alias      = "lakefs_s3_gateway"
+  access_key = ephemeral.lakefs_credentials.ci.access_key_id
+  secret_key = ephemeral.lakefs_credentials.ci.secret_access_key
+
+  endpoints {
+    s3 = "https://lakefs.example.com"
+  }
+}
@@ -0,0 +1,13 @@
+data "lakefs_policy_document" "readers" {
+  statement {
+    effect   = "allow"
+    action   = ["fs:ReadObject", "fs:ListObjects"]
+    resource = provider::lakefs::object_arn("example", "events/*")
+  }
+
+  statement {
+    effect   = "deny"
+    action   = ["fs:CreateCommit"]
+    resource = provider::lakefs::branch_arn("example", "main")
+  }
+}
@@ -0,0 +1,7 @@
+output "events_uri" {
+  value = provider::lakefs::uri(lakefs_repository.example.id, "main", "events/2024/")
+}
+
+output "events_ref" {
+  value = provider::lakefs::parse_uri("lakefs://example/main/events/2024/").ref
+}
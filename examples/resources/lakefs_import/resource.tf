@@ -0,0 +1,34 @@
+resource "lakefs_import" "orders" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+
+  sources = [
+    {
+      path        = "s3://raw-bucket/exports/orders/"
+      destination = "tables/orders/"
+      type        = "common_prefix"
+    },
+    {
+      path        = "s3://raw-bucket/exports/orders_schema.json"
+      destination = "tables/orders/_schema.json"
+      type        = "object"
+    },
+  ]
+
+  commit_message = "Import orders export"
+  commit_metadata = {
+    source = "nightly-export"
+  }
+
+  # Large imports can take longer than the default of 60 minutes
+  timeouts {
+    create = "3h"
+  }
+}
+
+# Pin the imported data for reproducible reads
+resource "lakefs_tag" "orders_import" {
+  repository = lakefs_repository.example.id
+  id         = "orders-import"
+  ref        = lakefs_import.orders.commit_id
+}
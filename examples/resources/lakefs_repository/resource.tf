@@ -3,3 +3,16 @@ resource "lakefs_repository" "example" {
   storage_namespace = "s3://my-bucket/lakefs/my-repository"
   default_branch    = "main"
 }
+
+# A scratch repository that may be destroyed with its data
+resource "lakefs_repository" "scratch" {
+  name              = "scratch"
+  storage_namespace = "s3://my-bucket/lakefs/scratch"
+  force_destroy     = true
+}
+
+# Without storage_namespace, the repository is created under the server's
+# default_namespace_prefix, e.g. s3://my-bucket/lakefs/derived
+resource "lakefs_repository" "derived" {
+  name = "derived"
+}
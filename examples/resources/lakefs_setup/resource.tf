@@ -0,0 +1,29 @@
+# Bootstraps a fresh installation with the key pair the provider is
+# configured with, so the whole environment comes up in one run
+variable "admin_access_key_id" {
+  type = string
+}
+
+variable "admin_secret_access_key" {
+  type      = string
+  sensitive = true
+}
+
+provider "lakefs" {
+  endpoint          = "http://localhost:8000/api/v1"
+  access_key_id     = var.admin_access_key_id
+  secret_access_key = var.admin_secret_access_key
+}
+
+resource "lakefs_setup" "this" {
+  username          = "admin"
+  access_key_id     = var.admin_access_key_id
+  secret_access_key = var.admin_secret_access_key
+}
+
+resource "lakefs_repository" "example" {
+  name              = "example"
+  storage_namespace = "local://example"
+
+  depends_on = [lakefs_setup.this]
+}
@@ -3,3 +3,11 @@ resource "lakefs_tag" "release" {
   id         = "v1.0.0"
   ref        = "main"
 }
+
+# A moving tag: changing ref retargets the tag in place
+resource "lakefs_tag" "prod_current" {
+  repository = lakefs_repository.example.id
+  id         = "prod-current"
+  ref        = var.prod_commit_id
+  force      = true
+}
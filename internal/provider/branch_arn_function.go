@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &BranchARNFunction{}
+
+func NewBranchARNFunction() function.Function {
+	return &BranchARNFunction{}
+}
+
+// BranchARNFunction defines the function implementation.
+type BranchARNFunction struct{}
+
+func (f *BranchARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "branch_arn"
+}
+
+func (f *BranchARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the policy resource ARN of a branch",
+		Description:         "Builds the arn:lakefs:fs:::repository/name/branch/branch ARN used in policy statements. The repository and branch may be names or patterns with * and ? wildcards.",
+		MarkdownDescription: "Builds the `arn:lakefs:fs:::repository/name/branch/branch` ARN used in policy statements. The repository and branch may be names or patterns with `*` and `?` wildcards.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "repository",
+				Description: "The name of the repository, or a wildcard pattern",
+			},
+			function.StringParameter{
+				Name:        "branch",
+				Description: "The name of the branch, or a wildcard pattern",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BranchARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var repository, branch string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &repository, &branch))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := naming.ValidateRepositoryPattern(repository); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if err := naming.ValidateBranchPattern(branch); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, repositoryARNPrefix+repository+"/branch/"+branch))
+}
@@ -22,7 +22,7 @@ func NewBranchDataSource() datasource.DataSource {
 
 // BranchDataSource defines the data source implementation.
 type BranchDataSource struct {
-	client *LakeFSClient
+	dataSourceBase
 }
 
 func (d *BranchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,23 +33,6 @@ func (d *BranchDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	resp.Schema = datasource_branch.BranchDataSourceSchema(ctx)
 }
 
-func (d *BranchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
-}
-
 func (d *BranchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data datasource_branch.BranchModel
 
@@ -63,16 +46,15 @@ func (d *BranchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	repository := data.Repository.ValueString()
 	branch := data.Branch.ValueString()
 
-	var result BranchResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branch), &result)
+	result, err := client.GetBranchWithResponse(ctx, repository, branch)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch: %s", err))
+		addClientError(&resp.Diagnostics, "read branch", err)
 		return
 	}
 
 	// Map response to state
 	data.Id = types.StringValue(fmt.Sprintf("%s/%s", repository, branch))
-	data.CommitId = types.StringValue(result.CommitID)
+	data.CommitId = types.StringValue(result.JSON200.CommitID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -7,17 +7,23 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BranchProtectionResource{}
 var _ resource.ResourceWithImportState = &BranchProtectionResource{}
+var _ resource.ResourceWithModifyPlan = &BranchProtectionResource{}
 
 func NewBranchProtectionResource() resource.Resource {
 	return &BranchProtectionResource{}
@@ -25,24 +31,17 @@ func NewBranchProtectionResource() resource.Resource {
 
 // BranchProtectionResource defines the resource implementation.
 type BranchProtectionResource struct {
-	client *LakeFSClient
+	resourceBase
 }
 
 // BranchProtectionModel describes the resource data model.
 type BranchProtectionModel struct {
-	Repository types.String `tfsdk:"repository"`
-	Id         types.String `tfsdk:"id"`
-	Rules      types.List   `tfsdk:"rules"`
-}
-
-// BranchProtectionRule represents a branch protection rule
-type BranchProtectionRule struct {
-	Pattern string `json:"pattern"`
+	Repository types.String   `tfsdk:"repository"`
+	Id         types.String   `tfsdk:"id"`
+	Rules      types.List     `tfsdk:"rules"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
-// BranchProtectionRulesResponse represents the API response
-type BranchProtectionRulesResponse []BranchProtectionRule
-
 func (r *BranchProtectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_branch_protection"
 }
@@ -74,6 +73,9 @@ resource "lakefs_branch_protection" "main" {
 			"repository": schema.StringAttribute{
 				Required:    true,
 				Description: "The repository ID to apply branch protection rules to.",
+				Validators: []validator.String{
+					naming.RepositoryName(),
+				},
 			},
 			"rules": schema.ListNestedAttribute{
 				Required:    true,
@@ -88,24 +90,26 @@ resource "lakefs_branch_protection" "main" {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func (r *BranchProtectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+// ModifyPlan reports servers that predate the branch protection settings
+// endpoints, which were added in lakeFS 1.3.0
+func (r *BranchProtectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	r.client = client
+	resp.Diagnostics.Append(checkServerRequirement(ctx, r.client, "lakefs_branch_protection", serverRequirement{MinVersion: "1.3.0"})...)
 }
 
 func (r *BranchProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -116,6 +120,15 @@ func (r *BranchProtectionResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	repository := data.Repository.ValueString()
 
@@ -132,9 +145,9 @@ func (r *BranchProtectionResource) Create(ctx context.Context, req resource.Crea
 	})
 
 	// LakeFS uses PUT to set branch protection rules
-	err := client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), rules, nil)
+	_, err := client.SetBranchProtectionRulesWithResponse(ctx, repository, rules)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch protection rules: %s", err))
+		addClientError(&resp.Diagnostics, "create branch protection rules", err)
 		return
 	}
 
@@ -154,22 +167,26 @@ func (r *BranchProtectionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	repository := data.Repository.ValueString()
 
-	var result BranchProtectionRulesResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), &result)
+	result, err := client.GetBranchProtectionRulesWithResponse(ctx, repository)
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch protection rules: %s", err))
+		readFailed(ctx, resp, "read branch protection rules", err)
 		return
 	}
 
 	// Convert rules to Terraform types
-	rulesList, diags := branchProtectionRulesToTerraformList(ctx, result)
+	rulesList, diags := branchProtectionRulesToTerraformList(ctx, *result.JSON200)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -189,6 +206,15 @@ func (r *BranchProtectionResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	repository := data.Repository.ValueString()
 
@@ -204,9 +230,9 @@ func (r *BranchProtectionResource) Update(ctx context.Context, req resource.Upda
 		"rules":      rules,
 	})
 
-	err := client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), rules, nil)
+	_, err := client.SetBranchProtectionRulesWithResponse(ctx, repository, rules)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branch protection rules: %s", err))
+		addClientError(&resp.Diagnostics, "update branch protection rules", err)
 		return
 	}
 
@@ -225,16 +251,25 @@ func (r *BranchProtectionResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	repository := data.Repository.ValueString()
 
 	tflog.Debug(ctx, "Deleting branch protection rules", map[string]any{"repository": repository})
 
 	// Delete by setting empty rules
-	err := client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), []BranchProtectionRule{}, nil)
+	_, err := client.SetBranchProtectionRulesWithResponse(ctx, repository, []lakefsapi.BranchProtectionRule{})
 	if err != nil {
 		if !IsNotFound(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch protection rules: %s", err))
+			addClientError(&resp.Diagnostics, "delete branch protection rules", err)
 			return
 		}
 	}
@@ -246,14 +281,13 @@ func (r *BranchProtectionResource) ImportState(ctx context.Context, req resource
 	client := NewAPIClient(r.client)
 	repository := req.ID
 
-	var result BranchProtectionRulesResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), &result)
+	result, err := client.GetBranchProtectionRulesWithResponse(ctx, repository)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import branch protection rules for %s: %s", repository, err))
 		return
 	}
 
-	rulesList, diags := branchProtectionRulesToTerraformList(ctx, result)
+	rulesList, diags := branchProtectionRulesToTerraformList(ctx, *result.JSON200)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -263,26 +297,27 @@ func (r *BranchProtectionResource) ImportState(ctx context.Context, req resource
 	data.Id = types.StringValue(repository)
 	data.Repository = types.StringValue(repository)
 	data.Rules = rulesList
+	data.Timeouts = nullTimeouts(resp.State)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // extractBranchProtectionRules extracts rules from Terraform types
-func extractBranchProtectionRules(ctx context.Context, rulesList types.List) ([]BranchProtectionRule, diag.Diagnostics) {
+func extractBranchProtectionRules(ctx context.Context, rulesList types.List) ([]lakefsapi.BranchProtectionRule, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if rulesList.IsNull() || rulesList.IsUnknown() {
 		return nil, diags
 	}
 
-	var rules []BranchProtectionRule
+	var rules []lakefsapi.BranchProtectionRule
 	elements := rulesList.Elements()
 
 	for _, elem := range elements {
 		obj := elem.(types.Object)
 		attrs := obj.Attributes()
 
-		rule := BranchProtectionRule{
+		rule := lakefsapi.BranchProtectionRule{
 			Pattern: attrs["pattern"].(types.String).ValueString(),
 		}
 		rules = append(rules, rule)
@@ -292,7 +327,7 @@ func extractBranchProtectionRules(ctx context.Context, rulesList types.List) ([]
 }
 
 // branchProtectionRulesToTerraformList converts rules to Terraform types.List
-func branchProtectionRulesToTerraformList(ctx context.Context, rules []BranchProtectionRule) (types.List, diag.Diagnostics) {
+func branchProtectionRulesToTerraformList(ctx context.Context, rules []lakefsapi.BranchProtectionRule) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	ruleAttrTypes := map[string]attr.Type{
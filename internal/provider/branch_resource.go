@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_branch"
 )
 
@@ -25,19 +26,7 @@ func NewBranchResource() resource.Resource {
 
 // BranchResource defines the resource implementation.
 type BranchResource struct {
-	client *LakeFSClient
-}
-
-// BranchCreateRequest represents the request to create a branch
-type BranchCreateRequest struct {
-	Name   string `json:"name"`
-	Source string `json:"source"`
-}
-
-// BranchResponse represents the API response for a branch
-type BranchResponse struct {
-	ID       string `json:"id"`
-	CommitID string `json:"commit_id"`
+	resourceBase
 }
 
 func (r *BranchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,23 +37,6 @@ func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = resource_branch.BranchResourceSchema(ctx)
 }
 
-func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
-}
-
 func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_branch.BranchModel
 
@@ -73,26 +45,39 @@ func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repository := data.Repository.ValueString()
-	createReq := BranchCreateRequest{
+	createReq := lakefsapi.BranchCreation{
 		Name:   data.Name.ValueString(),
 		Source: data.Source.ValueString(),
+		Force:  data.Force.ValueBool(),
+		Hidden: data.Hidden.ValueBool(),
 	}
 
 	tflog.Debug(ctx, "Creating branch", map[string]any{
 		"repository": repository,
 		"name":       createReq.Name,
 		"source":     createReq.Source,
+		"hidden":     createReq.Hidden,
 	})
 
 	// LakeFS branch creation returns a plain string (the commit ID), not JSON
-	commitID, err := client.PostRaw(ctx, fmt.Sprintf("/repositories/%s/branches", repository), createReq)
+	result, err := client.CreateBranchWithResponse(ctx, repository, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch: %s", err))
+		addClientError(&resp.Diagnostics, "create branch", err)
 		return
 	}
+	commitID := string(result.Body)
 
 	// Map response to state
 	data.Id = types.StringValue(fmt.Sprintf("%s/%s", repository, createReq.Name))
@@ -115,6 +100,15 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repository := data.Repository.ValueString()
@@ -123,19 +117,14 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 		branchName = data.Branch.ValueString()
 	}
 
-	var result BranchResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName), &result)
+	result, err := client.GetBranchWithResponse(ctx, repository, branchName)
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch: %s", err))
+		readFailed(ctx, resp, "read branch", err)
 		return
 	}
 
 	// Map response to state
-	data.CommitId = types.StringValue(result.CommitID)
+	data.CommitId = types.StringValue(result.JSON200.CommitID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -160,6 +149,15 @@ func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repository := data.Repository.ValueString()
@@ -173,12 +171,10 @@ func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		"branch":     branchName,
 	})
 
-	err := client.Delete(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName))
-	if err != nil {
-		if !IsNotFound(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch: %s", err))
-			return
-		}
+	_, err := client.DeleteBranchWithResponse(ctx, repository, branchName, nil)
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, "delete branch", err)
+		return
 	}
 
 	tflog.Trace(ctx, "Deleted branch", map[string]any{
@@ -202,8 +198,7 @@ func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportSta
 	repository := parts[0]
 	branchName := parts[1]
 
-	var result BranchResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName), &result)
+	result, err := client.GetBranchWithResponse(ctx, repository, branchName)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import branch %s: %s", req.ID, err))
 		return
@@ -214,8 +209,9 @@ func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportSta
 	data.Repository = types.StringValue(repository)
 	data.Name = types.StringValue(branchName)
 	data.Branch = types.StringValue(branchName)
-	data.CommitId = types.StringValue(result.CommitID)
+	data.CommitId = types.StringValue(result.JSON200.CommitID)
 	data.Source = types.StringValue("") // Source is not retrievable after creation
+	data.Timeouts = nullTimeouts(resp.State)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_branches"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BranchesDataSource{}
+
+func NewBranchesDataSource() datasource.DataSource {
+	return &BranchesDataSource{}
+}
+
+// BranchesDataSource defines the data source implementation.
+type BranchesDataSource struct {
+	dataSourceBase
+}
+
+func (d *BranchesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branches"
+}
+
+func (d *BranchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_branches.BranchesDataSourceSchema(ctx)
+}
+
+func (d *BranchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_branches.BranchesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	repository := data.Repository.ValueString()
+
+	results, err := client.listBranches(ctx, repository, lakefsapi.ListBranchesParams{
+		Prefix:     optional(data.Prefix.ValueString()),
+		ShowHidden: optional(data.ShowHidden.ValueBool()),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list branches", err)
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(repository)
+	data.Branches = make([]datasource_branches.BranchModel, 0, len(results))
+	for _, branch := range results {
+		data.Branches = append(data.Branches, datasource_branches.BranchModel{
+			Id:       types.StringValue(branch.ID),
+			CommitId: types.StringValue(branch.CommitID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -11,20 +11,34 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 )
 
-// APIClient is a client for the LakeFS API
+// APIClient is a client for the LakeFS API. The embedded
+// lakefsapi.ClientWithResponses provides the typed API operations, whose
+// requests are sent through Do.
 type APIClient struct {
+	*lakefsapi.ClientWithResponses
+
 	BaseURL    string
 	HTTPClient *http.Client
 	Username   string
 	Password   string
 }
 
+var _ lakefsapi.HttpRequestDoer = &APIClient{}
+
+// requestTimeout bounds a request made with a context that has no deadline,
+// such as from data sources. Resource operations set their deadline from
+// their timeouts block instead.
+const requestTimeout = 30 * time.Second
+
 // NewAPIClient creates a new LakeFS API client
 func NewAPIClient(config *LakeFSClient) *APIClient {
 	transport := &http.Transport{
@@ -33,140 +47,212 @@ func NewAPIClient(config *LakeFSClient) *APIClient {
 		},
 	}
 
-	return &APIClient{
+	client := &APIClient{
 		BaseURL: strings.TrimSuffix(config.Endpoint, "/"),
 		HTTPClient: &http.Client{
-			Timeout:   time.Second * 30,
 			Transport: transport,
 		},
 		Username: config.AccessKeyID,
 		Password: config.SecretAccessKey,
 	}
+	client.ClientWithResponses = &lakefsapi.ClientWithResponses{
+		ClientInterface: &lakefsapi.Client{Server: client.BaseURL + "/", Client: client},
+	}
+	return client
 }
 
-// Request performs an HTTP request to the LakeFS API
-func (c *APIClient) Request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	var bodyReader io.Reader
-
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
+// Do sends a request of the typed API client. Requests that fail transiently
+// are retried, see shouldRetry. Error responses, and successful responses the
+// API spec does not describe, are returned as errors, so that the typed
+// response bodies of the API client are set whenever a call succeeds.
+func (c *APIClient) Do(req *http.Request) (*http.Response, error) {
+	ctx, cancel := withRequestDeadline(req.Context())
+	defer cancel()
+	ctx = withLogMasking(ctx, c.Password)
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, fmt.Errorf("failed to read request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(jsonBody)
 	}
 
-	url := c.BaseURL + path
-	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	op, ok := lakefsapi.FindOperation(req.Method, strings.TrimPrefix(req.URL.EscapedPath(), c.basePath()))
+	var operation *lakefsapi.Operation
+	if ok {
+		operation = &op
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, status, err := c.attempt(ctx, req, body, operation, attempt)
+		if attempt >= maxRetries || !shouldRetry(req.Method, status, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := retryDelay(attempt)
+		tflog.Debug(ctx, "Retrying API request", map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"delay":   delay.String(),
+			"error":   err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return resp, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// basePath returns the path of the API endpoint, which prefixes the path of
+// every request
+func (c *APIClient) basePath() string {
+	endpoint, err := url.Parse(c.BaseURL)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return ""
 	}
+	return endpoint.EscapedPath()
+}
 
+// attempt performs a single request, the retry-th retry of a call of op, which
+// is nil for requests that are not in the API spec. It returns the response
+// with its body read into memory, and the HTTP status, which is 0 if no
+// response was received.
+func (c *APIClient) attempt(ctx context.Context, original *http.Request, body []byte, op *lakefsapi.Operation, retry int) (resp *http.Response, status int, err error) {
+	req := original.Clone(ctx)
+	req.Body = nil
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+
+	ctx, span := startRequestSpan(ctx, req, op, retry)
+	defer func() { endRequestSpan(span, status, err) }()
+	req = req.WithContext(ctx)
+
+	method, url := req.Method, req.URL.String()
+	requestID := newRequestID()
 	req.SetBasicAuth(c.Username, c.Password)
-	req.Header.Set("Content-Type", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set(requestIDHeader, requestID)
 
 	tflog.Debug(ctx, "Making API request", map[string]any{
-		"method": method,
-		"url":    url,
+		"method":     method,
+		"url":        url,
+		"request_id": requestID,
+		"body":       string(body),
 	})
 
-	resp, err := c.HTTPClient.Do(req)
+	start := time.Now()
+	resp, err = c.HTTPClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		tflog.Debug(ctx, "API request failed", map[string]any{
+			"method":     method,
+			"url":        url,
+			"request_id": requestID,
+			"latency_ms": time.Since(start).Milliseconds(),
+			"error":      err.Error(),
+		})
+		return nil, 0, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, resp.StatusCode, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// LakeFS echoes the request ID, or assigns one if a proxy dropped it
+	if id := resp.Header.Get(requestIDHeader); id != "" {
+		requestID = id
 	}
 
 	tflog.Debug(ctx, "API response", map[string]any{
-		"status": resp.StatusCode,
-		"body":   string(respBody),
+		"method":     method,
+		"url":        url,
+		"request_id": requestID,
+		"status":     resp.StatusCode,
+		"latency_ms": time.Since(start).Milliseconds(),
+		"body":       string(respBody),
 	})
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		var apiErr APIError
 		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
-			return &apiErr
+			// LakeFS error bodies usually carry only the message
+			if apiErr.Code == 0 {
+				apiErr.Code = resp.StatusCode
+			}
+			return nil, resp.StatusCode, &apiErr
 		}
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, resp.StatusCode, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
+	if op != nil {
+		if err := op.CheckResponse(resp.StatusCode, resp.Header.Get("Content-Type")); err != nil {
+			return nil, resp.StatusCode, err
 		}
 	}
 
-	return nil
-}
-
-// Get performs a GET request
-func (c *APIClient) Get(ctx context.Context, path string, result interface{}) error {
-	return c.Request(ctx, http.MethodGet, path, nil, result)
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, resp.StatusCode, nil
 }
 
-// Post performs a POST request
-func (c *APIClient) Post(ctx context.Context, path string, body interface{}, result interface{}) error {
-	return c.Request(ctx, http.MethodPost, path, body, result)
-}
-
-// PostRaw performs a POST request and returns the raw response body as a string
-// This is useful for APIs that return plain text instead of JSON
-func (c *APIClient) PostRaw(ctx context.Context, path string, body interface{}) (string, error) {
-	var bodyReader io.Reader
-
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal request body: %w", err)
-		}
-		bodyReader = bytes.NewReader(jsonBody)
-	}
-
-	url := c.BaseURL + path
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bodyReader)
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+// optional returns a pointer to v for an optional parameter of an API call,
+// or nil if v is the zero value, so that the parameter is omitted
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
 	}
+	return &v
+}
 
-	req.SetBasicAuth(c.Username, c.Password)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+// maxRetries is the number of times a failed request is retried
+const maxRetries = 3
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
+// retryBaseDelay is the delay before the first retry, doubled for each further
+// retry
+var retryBaseDelay = 500 * time.Millisecond
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %w", err)
+// shouldRetry reports whether a failed request may succeed when retried.
+// Requests the server rejected without processing them are always retried.
+// Other transient failures are only retried for idempotent methods, since a
+// POST may have taken effect.
+func shouldRetry(method string, status int, err error) bool {
+	if err == nil {
+		return false
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		var apiErr APIError
-		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
-			return "", &apiErr
-		}
-		return "", fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case 0, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return method != http.MethodPost
 	}
-
-	return string(respBody), nil
+	return false
 }
 
-// Put performs a PUT request
-func (c *APIClient) Put(ctx context.Context, path string, body interface{}, result interface{}) error {
-	return c.Request(ctx, http.MethodPut, path, body, result)
+// retryDelay returns the delay before the retry following attempt
+func retryDelay(attempt int) time.Duration {
+	return retryBaseDelay << attempt
 }
 
-// Delete performs a DELETE request
-func (c *APIClient) Delete(ctx context.Context, path string) error {
-	return c.Request(ctx, http.MethodDelete, path, nil, nil)
+// withRequestDeadline returns ctx unchanged if it has a deadline, and otherwise
+// a copy of ctx that expires after requestTimeout
+func withRequestDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, requestTimeout)
 }
 
 // APIError represents an error from the LakeFS API
@@ -192,3 +278,34 @@ func IsNotFound(err error) bool {
 	}
 	return false
 }
+
+// IgnoreNotFound returns nil if err reports that an object does not exist, so
+// that deleting an object that is already gone succeeds
+func IgnoreNotFound(err error) error {
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// IsConflict returns true if the error is a 409 Conflict error
+func IsConflict(err error) bool {
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.Code == 409
+	}
+	if err != nil {
+		return strings.Contains(err.Error(), "status 409")
+	}
+	return false
+}
+
+// IsNotImplemented returns true if the error is a 501 Not Implemented error
+func IsNotImplemented(err error) bool {
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.Code == 501
+	}
+	if err != nil {
+		return strings.Contains(err.Error(), "status 501")
+	}
+	return false
+}
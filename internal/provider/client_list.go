@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// The list operations of the LakeFS API return one page of results per call.
+// The functions below request every page, with the filters set in params.
+
+// listRepositories returns all repositories matching params
+func (c *APIClient) listRepositories(ctx context.Context, params lakefsapi.ListRepositoriesParams) ([]lakefsapi.Repository, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Repository, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListRepositoriesWithResponse(ctx, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listBranches returns all branches of repository matching params
+func (c *APIClient) listBranches(ctx context.Context, repository string, params lakefsapi.ListBranchesParams) ([]lakefsapi.Ref, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Ref, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListBranchesWithResponse(ctx, repository, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listTags returns all tags of repository matching params
+func (c *APIClient) listTags(ctx context.Context, repository string, params lakefsapi.ListTagsParams) ([]lakefsapi.Ref, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Ref, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListTagsWithResponse(ctx, repository, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// logCommits returns the whole commit log of ref matching params
+func (c *APIClient) logCommits(ctx context.Context, repository, ref string, params lakefsapi.LogCommitsParams) ([]lakefsapi.Commit, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Commit, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.LogCommitsWithResponse(ctx, repository, ref, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// diffBranch returns all uncommitted changes on branch matching params
+func (c *APIClient) diffBranch(ctx context.Context, repository, branch string, params lakefsapi.DiffBranchParams) ([]lakefsapi.Diff, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Diff, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.DiffBranchWithResponse(ctx, repository, branch, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// diffRefs returns all changes between leftRef and rightRef matching params
+func (c *APIClient) diffRefs(ctx context.Context, repository, leftRef, rightRef string, params lakefsapi.DiffRefsParams) ([]lakefsapi.Diff, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Diff, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.DiffRefsWithResponse(ctx, repository, leftRef, rightRef, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listUsers returns all users matching params
+func (c *APIClient) listUsers(ctx context.Context, params lakefsapi.ListUsersParams) ([]lakefsapi.User, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.User, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListUsersWithResponse(ctx, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listUserCredentials returns all credentials of a user matching params
+func (c *APIClient) listUserCredentials(ctx context.Context, userID string, params lakefsapi.ListUserCredentialsParams) ([]lakefsapi.Credentials, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Credentials, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListUserCredentialsWithResponse(ctx, userID, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listUserGroups returns all groups of a user matching params
+func (c *APIClient) listUserGroups(ctx context.Context, userID string, params lakefsapi.ListUserGroupsParams) ([]lakefsapi.Group, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Group, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListUserGroupsWithResponse(ctx, userID, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listUserPolicies returns all policies of a user matching params
+func (c *APIClient) listUserPolicies(ctx context.Context, userID string, params lakefsapi.ListUserPoliciesParams) ([]lakefsapi.Policy, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Policy, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListUserPoliciesWithResponse(ctx, userID, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listGroups returns all groups matching params
+func (c *APIClient) listGroups(ctx context.Context, params lakefsapi.ListGroupsParams) ([]lakefsapi.Group, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Group, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListGroupsWithResponse(ctx, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listGroupMembers returns all members of a group matching params
+func (c *APIClient) listGroupMembers(ctx context.Context, groupID string, params lakefsapi.ListGroupMembersParams) ([]lakefsapi.User, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.User, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListGroupMembersWithResponse(ctx, groupID, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listGroupPolicies returns all policies of a group matching params
+func (c *APIClient) listGroupPolicies(ctx context.Context, groupID string, params lakefsapi.ListGroupPoliciesParams) ([]lakefsapi.Policy, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Policy, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListGroupPoliciesWithResponse(ctx, groupID, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
+
+// listPolicies returns all policies matching params
+func (c *APIClient) listPolicies(ctx context.Context, params lakefsapi.ListPoliciesParams) ([]lakefsapi.Policy, error) {
+	return lakefsapi.ListAll(func(after string, amount int) ([]lakefsapi.Policy, lakefsapi.Pagination, error) {
+		params.After, params.Amount = optional(after), &amount
+		resp, err := c.ListPoliciesWithResponse(ctx, &params)
+		if err != nil {
+			return nil, lakefsapi.Pagination{}, err
+		}
+		return resp.JSON200.Results, resp.JSON200.Pagination, nil
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// requestIDHeader is the header LakeFS identifies requests by in its logs. A
+// request ID sent by the client is kept, so provider and server logs match.
+const requestIDHeader = "X-Request-ID"
+
+// sensitiveFields are the keys of the request and response body fields that
+// hold secrets
+var sensitiveFields = []string{
+	"secret_access_key",
+	"password",
+	"newPassword",
+	"token",
+}
+
+// sensitiveFieldValues matches the JSON members of sensitiveFields that have a
+// string value
+var sensitiveFieldValues = regexp.MustCompile(`"(?:` + strings.Join(sensitiveFields, "|") + `)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// withLogMasking returns a copy of ctx whose logs mask sensitive fields, both
+// as log fields and within logged request and response bodies, and the given
+// secrets wherever they appear
+func withLogMasking(ctx context.Context, secrets ...string) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveFields...)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, sensitiveFieldValues)
+	for _, secret := range secrets {
+		// Masking an empty string would mask between every character
+		if secret != "" {
+			ctx = tflog.MaskAllFieldValuesStrings(ctx, secret)
+		}
+	}
+	return ctx
+}
+
+// newRequestID returns a random ID for a request
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
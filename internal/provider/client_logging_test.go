@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+func TestRequestLogsMaskSecrets(t *testing.T) {
+	var requestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get(requestIDHeader))
+		testWriteJSON(w, http.StatusOK, json.RawMessage(`{"access_key_id":"AKIAGENERATED","secret_access_key":"generated-secret","creation_date":1}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL, AccessKeyID: "AKIAADMIN", SecretAccessKey: "admin-secret"})
+
+	_, err := client.SetupWithResponse(ctx, lakefsapi.Setup{
+		Username: "admin",
+		Key:      &lakefsapi.AccessKeyCredentials{AccessKeyID: "AKIAPROVIDED", SecretAccessKey: "provided-secret"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	logs := output.String()
+	for _, secret := range []string{"generated-secret", "provided-secret", "admin-secret"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("expected %s to be masked, got logs: %s", secret, logs)
+		}
+	}
+	for _, expected := range []string{"AKIAGENERATED", "AKIAPROVIDED"} {
+		if !strings.Contains(logs, expected) {
+			t.Errorf("expected %s to be logged, got logs: %s", expected, logs)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var response map[string]any
+	for _, entry := range entries {
+		if entry["@message"] == "API response" {
+			response = entry
+		}
+	}
+	if response == nil {
+		t.Fatalf("expected a response log entry, got: %v", entries)
+	}
+	if len(requestIDs) != 1 || response["request_id"] != requestIDs[0] {
+		t.Errorf("expected the request ID %v to be logged, got: %v", requestIDs, response["request_id"])
+	}
+	if _, ok := response["latency_ms"]; !ok {
+		t.Errorf("expected the latency to be logged, got: %v", response)
+	}
+}
+
+func TestLogMaskingMatchesSensitiveFields(t *testing.T) {
+	tests := map[string]bool{
+		`{"secret_access_key":"s"}`:           true,
+		`{"password" : "p\"q"}`:               true,
+		`{"token":"t"}`:                       true,
+		`{"access_key_id":"AKIA"}`:            false,
+		`{"token_expiration":"2024-01-01"}`:   false,
+		`{"description":"password rotation"}`: false,
+	}
+
+	for body, sensitive := range tests {
+		if sensitiveFieldValues.MatchString(body) != sensitive {
+			t.Errorf("unexpected match for %s, expected sensitive: %t", body, sensitive)
+		}
+	}
+}
+
+func TestNewRequestIDIsUnique(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		id := newRequestID()
+		if seen[id] {
+			t.Fatalf("duplicate request ID %s", id)
+		}
+		seen[id] = true
+	}
+	if id := newRequestID(); len(id) != 16 {
+		t.Errorf("unexpected request ID %q", id)
+	}
+}
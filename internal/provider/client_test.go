@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// testWriteJSON writes a JSON response with status, as LakeFS does
+func testWriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestListMethodsFollowPagination(t *testing.T) {
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Query())
+
+		page := map[string]any{
+			"pagination": map[string]any{"has_more": true, "next_offset": "b"},
+			"results":    []map[string]string{{"id": "a"}, {"id": "b"}},
+		}
+		if r.URL.Query().Get("after") == "b" {
+			page = map[string]any{
+				"pagination": map[string]any{"has_more": false, "next_offset": ""},
+				"results":    []map[string]string{{"id": "c"}},
+			}
+		}
+		testWriteJSON(w, http.StatusOK, page)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	results, err := client.listTags(context.Background(), "repo", lakefsapi.ListTagsParams{Prefix: optional("v")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(results) != 3 || results[2].ID != "c" {
+		t.Errorf("unexpected results: %+v", results)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].Get("prefix") != "v" || requests[1].Get("prefix") != "v" {
+		t.Errorf("expected the prefix to be sent with every page: %v", requests)
+	}
+	if requests[0].Has("after") {
+		t.Errorf("unexpected after on the first page: %v", requests[0])
+	}
+}
+
+func TestRequestErrorCarriesStatusCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testWriteJSON(w, http.StatusNotFound, map[string]string{"message": "not found"})
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	_, err := client.GetRepositoryWithResponse(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+	if IsConflict(err) {
+		t.Errorf("did not expect a conflict error, got: %v", err)
+	}
+}
+
+func TestRequestRespectsContextDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.ListRepositoriesWithResponse(ctx, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline exceeded error, got: %v", err)
+	}
+}
+
+func TestRequestRetriesTransientFailures(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			testWriteJSON(w, http.StatusServiceUnavailable, map[string]string{"message": "unavailable"})
+			return
+		}
+		testWriteJSON(w, http.StatusCreated, map[string]string{"id": "repo"})
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	result, err := client.CreateRepositoryWithResponse(context.Background(), nil, lakefsapi.RepositoryCreation{Name: "repo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requests != 3 || result.JSON201.ID != "repo" {
+		t.Errorf("expected success after 3 requests, got %d requests and %+v", requests, result.JSON201)
+	}
+}
+
+func TestRequestDoesNotRetryAmbiguousPost(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	if _, err := client.CreateRepositoryWithResponse(context.Background(), nil, lakefsapi.RepositoryCreation{Name: "repo"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != 1 {
+		t.Errorf("expected a POST to be sent once, got %d requests", requests)
+	}
+
+	requests = 0
+	if _, err := client.ListRepositoriesWithResponse(context.Background(), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != maxRetries+1 {
+		t.Errorf("expected a GET to be retried %d times, got %d requests", maxRetries, requests)
+	}
+}
+
+func TestResponseOutsideSpecIsAnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html>login</html>"))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	result, err := client.GetRepositoryWithResponse(context.Background(), "repo")
+	if err == nil {
+		t.Fatalf("expected an error, got: %+v", result)
+	}
+}
+
+func TestIgnoreNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testWriteJSON(w, http.StatusNotFound, map[string]string{"message": "not found"})
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	_, err := client.DeletePolicyWithResponse(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+	if err := IgnoreNotFound(err); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := IgnoreNotFound(errors.New("unavailable")); err == nil {
+		t.Error("expected other errors to be kept")
+	}
+}
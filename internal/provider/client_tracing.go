@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/tracing"
+)
+
+// operationIDKey is the span attribute holding the LakeFS API operation of a
+// request
+const operationIDKey = attribute.Key("lakefs.operation_id")
+
+// startRequestSpan starts the span of a request, which is the retry-th retry
+// of a call of op, and propagates its trace context to LakeFS in the request
+// headers. Spans are named after the path template of the operation, so that
+// requests for different objects are grouped. op is nil for requests that are
+// not in the API spec.
+func startRequestSpan(ctx context.Context, req *http.Request, op *lakefsapi.Operation, retry int) (context.Context, trace.Span) {
+	name := req.Method
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.URLFull(req.URL.String()),
+		semconv.ServerAddress(req.URL.Hostname()),
+	}
+	if port, err := strconv.Atoi(req.URL.Port()); err == nil {
+		attrs = append(attrs, semconv.ServerPort(port))
+	}
+	if op != nil {
+		name += " " + op.PathTemplate
+		attrs = append(attrs, semconv.URLTemplate(op.PathTemplate), operationIDKey.String(op.ID))
+	}
+	if retry > 0 {
+		attrs = append(attrs, semconv.HTTPRequestResendCount(retry))
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return ctx, span
+}
+
+// endRequestSpan ends the span of a request, which failed if no response was
+// received or LakeFS returned an error
+func endRequestSpan(span trace.Span, status int, err error) {
+	defer span.End()
+
+	if status != 0 {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+	}
+	switch {
+	case status >= 400:
+		span.SetAttributes(semconv.ErrorTypeKey.String(strconv.Itoa(status)))
+		span.SetStatus(codes.Error, http.StatusText(status))
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/tracing"
+)
+
+func TestRequestSpans(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	recorder := tracetest.NewSpanRecorder()
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	defer otel.SetTracerProvider(provider)
+	defer otel.SetTextMapPropagator(propagator)
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		if len(traceparents) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		testWriteJSON(w, http.StatusOK, json.RawMessage(`{"id":"main","commit_id":"abc"}`))
+	}))
+	defer server.Close()
+
+	ctx, parent := tracing.Tracer().Start(context.Background(), "lakefs_branch Read")
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL, AccessKeyID: "AKIA", SecretAccessKey: "secret"})
+	if _, err := client.GetBranchWithResponse(ctx, "repo", "main"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	parent.End()
+
+	var spans []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Parent().SpanID() == parent.SpanContext().SpanID() {
+			spans = append(spans, span)
+		}
+	}
+	if len(spans) != 2 {
+		t.Fatalf("expected a span per attempt, got: %v", recorder.Ended())
+	}
+
+	for i, span := range spans {
+		if span.Name() != "GET /repositories/{repository}/branches/{branch}" {
+			t.Errorf("unexpected span name %s", span.Name())
+		}
+		if v := spanAttribute(span, "http.request.method"); v.AsString() != http.MethodGet {
+			t.Errorf("unexpected method %v", v)
+		}
+		if v := spanAttribute(span, "url.template"); v.AsString() != "/repositories/{repository}/branches/{branch}" {
+			t.Errorf("unexpected path template %v", v)
+		}
+		if v := spanAttribute(span, operationIDKey); v.AsString() != "getBranch" {
+			t.Errorf("unexpected operation %v", v)
+		}
+		resend := spanAttribute(span, "http.request.resend_count")
+		if i == 0 && resend.Type() != attribute.INVALID {
+			t.Errorf("unexpected resend count %v on the first attempt", resend)
+		}
+		if i == 1 && resend.AsInt64() != 1 {
+			t.Errorf("expected resend count 1, got %v", resend)
+		}
+
+		if traceparents[i] != "00-"+span.SpanContext().TraceID().String()+"-"+span.SpanContext().SpanID().String()+"-01" {
+			t.Errorf("expected the span to be propagated, got traceparent %q", traceparents[i])
+		}
+	}
+
+	if v := spanAttribute(spans[0], "http.response.status_code"); v.AsInt64() != http.StatusServiceUnavailable {
+		t.Errorf("unexpected status %v", v)
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("expected the failed attempt to have an error status, got: %v", spans[0].Status())
+	}
+	if v := spanAttribute(spans[1], "http.response.status_code"); v.AsInt64() != http.StatusOK {
+		t.Errorf("unexpected status %v", v)
+	}
+	if spans[1].Status().Code == codes.Error {
+		t.Errorf("unexpected error status: %v", spans[1].Status())
+	}
+}
+
+// spanAttribute returns the value of a span attribute, which is invalid if the
+// span does not have it
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return attribute.Value{}
+}
@@ -5,7 +5,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -23,20 +22,7 @@ func NewCommitDataSource() datasource.DataSource {
 
 // CommitDataSource defines the data source implementation.
 type CommitDataSource struct {
-	client *LakeFSClient
-}
-
-// CommitResponse represents the API response for a commit
-type CommitResponse struct {
-	ID           string            `json:"id"`
-	Committer    string            `json:"committer"`
-	Message      string            `json:"message"`
-	MetaRangeID  string            `json:"meta_range_id"`
-	CreationDate int64             `json:"creation_date"`
-	Parents      []string          `json:"parents"`
-	Metadata     map[string]string `json:"metadata"`
-	Generation   int64             `json:"generation"`
-	Version      int64             `json:"version"`
+	dataSourceBase
 }
 
 func (d *CommitDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,23 +33,6 @@ func (d *CommitDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	resp.Schema = datasource_commit.CommitDataSourceSchema(ctx)
 }
 
-func (d *CommitDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
-}
-
 func (d *CommitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data datasource_commit.CommitModel
 
@@ -77,26 +46,25 @@ func (d *CommitDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	repository := data.Repository.ValueString()
 	commitID := data.CommitId.ValueString()
 
-	var result CommitResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/commits/%s", repository, commitID), &result)
+	result, err := client.GetCommitWithResponse(ctx, repository, commitID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read commit: %s", err))
+		addClientError(&resp.Diagnostics, "read commit", err)
 		return
 	}
 
 	// Map response to state
-	data.Id = types.StringValue(result.ID)
-	data.Committer = types.StringValue(result.Committer)
-	data.Message = types.StringValue(result.Message)
-	data.MetaRangeId = types.StringValue(result.MetaRangeID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	data.Generation = types.Int64Value(result.Generation)
-	data.Version = types.Int64Value(result.Version)
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.Committer = types.StringValue(result.JSON200.Committer)
+	data.Message = types.StringValue(result.JSON200.Message)
+	data.MetaRangeId = types.StringValue(result.JSON200.MetaRangeID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	data.Generation = types.Int64Value(result.JSON200.Generation)
+	data.Version = types.Int64Value(int64(result.JSON200.Version))
 
 	// Convert parents to list
-	if len(result.Parents) > 0 {
+	if len(result.JSON200.Parents) > 0 {
 		var parentValues []attr.Value
-		for _, p := range result.Parents {
+		for _, p := range result.JSON200.Parents {
 			parentValues = append(parentValues, types.StringValue(p))
 		}
 		parentsList, _ := types.ListValue(types.StringType, parentValues)
@@ -106,9 +74,9 @@ func (d *CommitDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Convert metadata to map
-	if len(result.Metadata) > 0 {
+	if len(result.JSON200.Metadata) > 0 {
 		metadataValues := make(map[string]attr.Value)
-		for k, v := range result.Metadata {
+		for k, v := range result.JSON200.Metadata {
 			metadataValues[k] = types.StringValue(v)
 		}
 		metadataMap, _ := types.MapValue(types.StringType, metadataValues)
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_commit_log"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CommitLogDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &CommitLogDataSource{}
+
+func NewCommitLogDataSource() datasource.DataSource {
+	return &CommitLogDataSource{}
+}
+
+// CommitLogDataSource defines the data source implementation.
+type CommitLogDataSource struct {
+	dataSourceBase
+}
+
+func (d *CommitLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commit_log"
+}
+
+func (d *CommitLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_commit_log.CommitLogDataSourceSchema(ctx)
+}
+
+func (d *CommitLogDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var since types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("since"), &since)...)
+	if resp.Diagnostics.HasError() || since.IsNull() || since.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, since.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("since"),
+			"Invalid Timestamp",
+			fmt.Sprintf("Unable to parse %q as an RFC 3339 timestamp: %s", since.ValueString(), err),
+		)
+	}
+}
+
+func (d *CommitLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_commit_log.CommitLogModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	repository := data.Repository.ValueString()
+	ref := data.Ref.ValueString()
+
+	params := lakefsapi.LogCommitsParams{
+		FirstParent: optional(data.FirstParent.ValueBool()),
+		StopAt:      optional(data.StopAt.ValueString()),
+	}
+	if !data.Since.IsNull() {
+		// ValidateConfig has checked the timestamp
+		since, _ := time.Parse(time.RFC3339, data.Since.ValueString())
+		params.Since = &since
+	}
+	var objects, prefixes []string
+	if !data.Objects.IsNull() {
+		resp.Diagnostics.Append(data.Objects.ElementsAs(ctx, &objects, false)...)
+		params.Objects = &objects
+	}
+	if !data.Prefixes.IsNull() {
+		resp.Diagnostics.Append(data.Prefixes.ElementsAs(ctx, &prefixes, false)...)
+		params.Prefixes = &prefixes
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading commit log", map[string]any{
+		"repository": repository,
+		"ref":        ref,
+		"objects":    objects,
+		"prefixes":   prefixes,
+	})
+
+	var results []lakefsapi.Commit
+	if !data.Amount.IsNull() {
+		// limit makes amount the total number of results instead of the page size
+		params.Amount = optional(int(data.Amount.ValueInt64()))
+		params.Limit = optional(true)
+
+		page, err := client.LogCommitsWithResponse(ctx, repository, ref, &params)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read commit log", err)
+			return
+		}
+		results = page.JSON200.Results
+	} else {
+		var err error
+		results, err = client.logCommits(ctx, repository, ref, params)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read commit log", err)
+			return
+		}
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", repository, ref))
+	data.Commits = make([]datasource_commit_log.CommitModel, 0, len(results))
+	for _, commit := range results {
+		parents, diags := types.ListValueFrom(ctx, types.StringType, commit.Parents)
+		resp.Diagnostics.Append(diags...)
+		metadata, diags := types.MapValueFrom(ctx, types.StringType, commit.Metadata)
+		resp.Diagnostics.Append(diags...)
+
+		data.Commits = append(data.Commits, datasource_commit_log.CommitModel{
+			Id:           types.StringValue(commit.ID),
+			Committer:    types.StringValue(commit.Committer),
+			Message:      types.StringValue(commit.Message),
+			CreationDate: types.Int64Value(commit.CreationDate),
+			MetaRangeId:  types.StringValue(commit.MetaRangeID),
+			Parents:      parents,
+			Metadata:     metadata,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
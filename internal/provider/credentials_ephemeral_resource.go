@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/ephemeral_credentials"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &CredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &CredentialsEphemeralResource{}
+
+// credentialsPrivateKey is the private data key holding the key pair to delete on Close
+const credentialsPrivateKey = "credentials"
+
+func NewCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &CredentialsEphemeralResource{}
+}
+
+// CredentialsEphemeralResource defines the ephemeral resource implementation.
+type CredentialsEphemeralResource struct {
+	ephemeralResourceBase
+}
+
+// credentialsPrivateData identifies the key pair created by Open
+type credentialsPrivateData struct {
+	UserID      string `json:"user_id"`
+	AccessKeyID string `json:"access_key_id"`
+}
+
+func (e *CredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials"
+}
+
+func (e *CredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ephemeral_credentials.CredentialsEphemeralResourceSchema(ctx)
+}
+
+func (e *CredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeral_credentials.CredentialsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(e.client)
+
+	userID := data.UserId.ValueString()
+	if data.UserId.IsNull() {
+		currentUser, err := client.GetCurrentUserWithResponse(ctx)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read current user", err)
+			return
+		}
+		userID = currentUser.JSON200.User.ID
+	}
+
+	result, err := client.CreateCredentialsWithResponse(ctx, userID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("create credentials for user %s", userID), err)
+		return
+	}
+
+	private, err := json.Marshal(credentialsPrivateData{
+		UserID:      userID,
+		AccessKeyID: result.JSON201.AccessKeyID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Marshal Error", fmt.Sprintf("Unable to marshal private data: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, credentialsPrivateKey, private)...)
+
+	data.UserId = types.StringValue(userID)
+	data.AccessKeyId = types.StringValue(result.JSON201.AccessKeyID)
+	data.SecretAccessKey = types.StringValue(result.JSON201.SecretAccessKey)
+	data.CreationDate = types.Int64Value(result.JSON201.CreationDate)
+
+	tflog.Debug(ctx, "Opened ephemeral credentials", map[string]any{
+		"user_id":       userID,
+		"access_key_id": result.JSON201.AccessKeyID,
+	})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *CredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, credentialsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var data credentialsPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Unmarshal Error", fmt.Sprintf("Unable to unmarshal private data: %s", err))
+		return
+	}
+
+	client := NewAPIClient(e.client)
+
+	if err := deleteUserCredentials(ctx, client, data.UserID, data.AccessKeyID); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("delete credentials %s for user %s", data.AccessKeyID, data.UserID), err)
+		return
+	}
+
+	tflog.Debug(ctx, "Closed ephemeral credentials", map[string]any{
+		"user_id":       data.UserID,
+		"access_key_id": data.AccessKeyID,
+	})
+}
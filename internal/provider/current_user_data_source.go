@@ -5,7 +5,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,7 +20,7 @@ func NewCurrentUserDataSource() datasource.DataSource {
 
 // CurrentUserDataSource defines the data source implementation.
 type CurrentUserDataSource struct {
-	client *LakeFSClient
+	dataSourceBase
 }
 
 // CurrentUserModel describes the data source data model.
@@ -32,16 +31,6 @@ type CurrentUserModel struct {
 	CreationDate types.Int64  `tfsdk:"creation_date"`
 }
 
-// CurrentUserResponse represents the API response for current user
-type CurrentUserResponse struct {
-	User struct {
-		ID           string `json:"id"`
-		Email        string `json:"email"`
-		FriendlyName string `json:"friendly_name"`
-		CreationDate int64  `json:"creation_date"`
-	} `json:"user"`
-}
-
 func (d *CurrentUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_current_user"
 }
@@ -81,23 +70,6 @@ output "current_user_id" {
 	}
 }
 
-func (d *CurrentUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
-}
-
 func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data CurrentUserModel
 
@@ -108,18 +80,17 @@ func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	client := NewAPIClient(d.client)
 
-	var result CurrentUserResponse
-	err := client.Get(ctx, "/user", &result)
+	result, err := client.GetCurrentUserWithResponse(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current user: %s", err))
+		addClientError(&resp.Diagnostics, "read current user", err)
 		return
 	}
 
 	// Map response to state
-	data.Id = types.StringValue(result.User.ID)
-	data.Email = types.StringValue(result.User.Email)
-	data.FriendlyName = types.StringValue(result.User.FriendlyName)
-	data.CreationDate = types.Int64Value(result.User.CreationDate)
+	data.Id = types.StringValue(result.JSON200.User.ID)
+	data.Email = types.StringValue(result.JSON200.User.Email)
+	data.FriendlyName = types.StringValue(result.JSON200.User.FriendlyName)
+	data.CreationDate = types.Int64Value(result.JSON200.User.CreationDate)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,67 @@
+package datasource_branches
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func BranchesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists all branches of a repository",
+		MarkdownDescription: "Lists all branches of a repository",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "The repository to list branches of",
+				MarkdownDescription: "The repository to list branches of",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only branches whose name starts with this prefix",
+				MarkdownDescription: "Return only branches whose name starts with this prefix",
+			},
+			"show_hidden": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Include hidden branches (defaults to false)",
+				MarkdownDescription: "Include hidden branches (defaults to `false`)",
+			},
+			"branches": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching branches",
+				MarkdownDescription: "The matching branches",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the branch",
+							MarkdownDescription: "The name of the branch",
+						},
+						"commit_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The commit ID the branch points to",
+							MarkdownDescription: "The commit ID the branch points to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type BranchesModel struct {
+	Id         types.String  `tfsdk:"id"`
+	Repository types.String  `tfsdk:"repository"`
+	Prefix     types.String  `tfsdk:"prefix"`
+	ShowHidden types.Bool    `tfsdk:"show_hidden"`
+	Branches   []BranchModel `tfsdk:"branches"`
+}
+
+type BranchModel struct {
+	Id       types.String `tfsdk:"id"`
+	CommitId types.String `tfsdk:"commit_id"`
+}
@@ -0,0 +1,136 @@
+package datasource_commit_log
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CommitLogDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the commits reachable from a ref, newest first",
+		MarkdownDescription: "Lists the commits reachable from a ref, newest first",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the repository",
+				MarkdownDescription: "The name of the repository",
+			},
+			"ref": schema.StringAttribute{
+				Required:            true,
+				Description:         "The branch, tag or commit ID to start the log from",
+				MarkdownDescription: "The branch, tag or commit ID to start the log from",
+			},
+			"amount": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of commits to return (1-1000). When unset, the whole matching history is returned",
+				MarkdownDescription: "The maximum number of commits to return (1-1000). When unset, the whole matching history is returned",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"objects": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Return only commits that changed one of these object paths",
+				MarkdownDescription: "Return only commits that changed one of these object paths",
+			},
+			"prefixes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Return only commits that changed an object under one of these prefixes (e.g., tables/orders/)",
+				MarkdownDescription: "Return only commits that changed an object under one of these prefixes (e.g., `tables/orders/`)",
+			},
+			"since": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only commits created at or after this RFC 3339 timestamp (e.g., 2024-01-01T00:00:00Z)",
+				MarkdownDescription: "Return only commits created at or after this RFC 3339 timestamp (e.g., `2024-01-01T00:00:00Z`)",
+			},
+			"first_parent": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Follow only the first parent of merge commits",
+				MarkdownDescription: "Follow only the first parent of merge commits",
+			},
+			"stop_at": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A ref at which to stop the log. The commit it resolves to is included",
+				MarkdownDescription: "A ref at which to stop the log. The commit it resolves to is included",
+			},
+			"commits": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching commits, newest first",
+				MarkdownDescription: "The matching commits, newest first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The commit ID",
+							MarkdownDescription: "The commit ID",
+						},
+						"committer": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The user that created the commit",
+							MarkdownDescription: "The user that created the commit",
+						},
+						"message": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The commit message",
+							MarkdownDescription: "The commit message",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Unix Epoch in seconds",
+							MarkdownDescription: "Unix Epoch in seconds",
+						},
+						"meta_range_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The meta range ID of the commit",
+							MarkdownDescription: "The meta range ID of the commit",
+						},
+						"parents": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The IDs of the parent commits",
+							MarkdownDescription: "The IDs of the parent commits",
+						},
+						"metadata": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The commit metadata",
+							MarkdownDescription: "The commit metadata",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type CommitLogModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Repository  types.String  `tfsdk:"repository"`
+	Ref         types.String  `tfsdk:"ref"`
+	Amount      types.Int64   `tfsdk:"amount"`
+	Objects     types.List    `tfsdk:"objects"`
+	Prefixes    types.List    `tfsdk:"prefixes"`
+	Since       types.String  `tfsdk:"since"`
+	FirstParent types.Bool    `tfsdk:"first_parent"`
+	StopAt      types.String  `tfsdk:"stop_at"`
+	Commits     []CommitModel `tfsdk:"commits"`
+}
+
+type CommitModel struct {
+	Id           types.String `tfsdk:"id"`
+	Committer    types.String `tfsdk:"committer"`
+	Message      types.String `tfsdk:"message"`
+	CreationDate types.Int64  `tfsdk:"creation_date"`
+	MetaRangeId  types.String `tfsdk:"meta_range_id"`
+	Parents      types.List   `tfsdk:"parents"`
+	Metadata     types.Map    `tfsdk:"metadata"`
+}
@@ -0,0 +1,163 @@
+package datasource_diff
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DiffDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the differences between two refs, or the uncommitted changes of a branch",
+		MarkdownDescription: "Lists the differences between two refs, or the uncommitted changes of a branch",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the repository",
+				MarkdownDescription: "The name of the repository",
+			},
+			"left_ref": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ref to compare from (e.g., the destination of a merge). When right_ref is unset, this must be a branch and its uncommitted changes are listed",
+				MarkdownDescription: "The ref to compare from (e.g., the destination of a merge). When `right_ref` is unset, this must be a branch and its uncommitted changes are listed",
+			},
+			"right_ref": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ref to compare to (e.g., the source of a merge)",
+				MarkdownDescription: "The ref to compare to (e.g., the source of a merge)",
+			},
+			"diff_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How to compare the refs: three_dot compares right_ref with the merge base (what a merge would bring in), two_dot compares the refs directly. Defaults to three_dot. Only used with right_ref",
+				MarkdownDescription: "How to compare the refs: `three_dot` compares `right_ref` with the merge base (what a merge would bring in), `two_dot` compares the refs directly. Defaults to `three_dot`. Only used with `right_ref`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("two_dot", "three_dot"),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only entries under this path prefix",
+				MarkdownDescription: "Return only entries under this path prefix",
+			},
+			"delimiter": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Group entries sharing a prefix up to this delimiter (e.g., /) into a single common prefix entry",
+				MarkdownDescription: "Group entries sharing a prefix up to this delimiter (e.g., `/`) into a single common prefix entry",
+			},
+			"types": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Return only entries of these change types (added, removed, changed, conflict, prefix_changed)",
+				MarkdownDescription: "Return only entries of these change types (`added`, `removed`, `changed`, `conflict`, `prefix_changed`)",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("added", "removed", "changed", "conflict", "prefix_changed"),
+					),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The differences, ordered by path",
+				MarkdownDescription: "The differences, ordered by path",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The path of the object or common prefix",
+							MarkdownDescription: "The path of the object or common prefix",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The change type (added, removed, changed, conflict or prefix_changed)",
+							MarkdownDescription: "The change type (`added`, `removed`, `changed`, `conflict` or `prefix_changed`)",
+						},
+						"path_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Whether the path is an object or a common_prefix",
+							MarkdownDescription: "Whether the path is an `object` or a `common_prefix`",
+						},
+						"size_bytes": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The size of the object, when known",
+							MarkdownDescription: "The size of the object, when known",
+						},
+					},
+				},
+			},
+			"summary": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "The number of returned entries per change type",
+				MarkdownDescription: "The number of returned entries per change type",
+				Attributes: map[string]schema.Attribute{
+					"added": schema.Int64Attribute{
+						Computed: true,
+					},
+					"removed": schema.Int64Attribute{
+						Computed: true,
+					},
+					"changed": schema.Int64Attribute{
+						Computed: true,
+					},
+					"conflict": schema.Int64Attribute{
+						Computed: true,
+					},
+					"prefix_changed": schema.Int64Attribute{
+						Computed: true,
+					},
+					"total": schema.Int64Attribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type DiffModel struct {
+	Id         types.String `tfsdk:"id"`
+	Repository types.String `tfsdk:"repository"`
+	LeftRef    types.String `tfsdk:"left_ref"`
+	RightRef   types.String `tfsdk:"right_ref"`
+	DiffType   types.String `tfsdk:"diff_type"`
+	Prefix     types.String `tfsdk:"prefix"`
+	Delimiter  types.String `tfsdk:"delimiter"`
+	Types      types.List   `tfsdk:"types"`
+	Entries    []EntryModel `tfsdk:"entries"`
+	Summary    types.Object `tfsdk:"summary"`
+}
+
+type EntryModel struct {
+	Path      types.String `tfsdk:"path"`
+	Type      types.String `tfsdk:"type"`
+	PathType  types.String `tfsdk:"path_type"`
+	SizeBytes types.Int64  `tfsdk:"size_bytes"`
+}
+
+type SummaryModel struct {
+	Added         types.Int64 `tfsdk:"added"`
+	Removed       types.Int64 `tfsdk:"removed"`
+	Changed       types.Int64 `tfsdk:"changed"`
+	Conflict      types.Int64 `tfsdk:"conflict"`
+	PrefixChanged types.Int64 `tfsdk:"prefix_changed"`
+	Total         types.Int64 `tfsdk:"total"`
+}
+
+// SummaryAttrTypes returns the attribute types of the summary object
+func SummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"added":          types.Int64Type,
+		"removed":        types.Int64Type,
+		"changed":        types.Int64Type,
+		"conflict":       types.Int64Type,
+		"prefix_changed": types.Int64Type,
+		"total":          types.Int64Type,
+	}
+}
@@ -0,0 +1,75 @@
+package datasource_groups
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GroupsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists all groups together with their members and policies",
+		MarkdownDescription: "Lists all groups together with their members and policies",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only groups whose ID starts with this prefix",
+				MarkdownDescription: "Return only groups whose ID starts with this prefix",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching groups",
+				MarkdownDescription: "The matching groups",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the group",
+							MarkdownDescription: "The name of the group",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Unix Epoch in seconds",
+							MarkdownDescription: "Unix Epoch in seconds",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "A description of the group",
+							MarkdownDescription: "A description of the group",
+						},
+						"members": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "IDs of the users in the group",
+							MarkdownDescription: "IDs of the users in the group",
+						},
+						"policies": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "IDs of the policies attached to the group",
+							MarkdownDescription: "IDs of the policies attached to the group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type GroupsModel struct {
+	Id     types.String `tfsdk:"id"`
+	Prefix types.String `tfsdk:"prefix"`
+	Groups []GroupModel `tfsdk:"groups"`
+}
+
+type GroupModel struct {
+	Id           types.String `tfsdk:"id"`
+	CreationDate types.Int64  `tfsdk:"creation_date"`
+	Description  types.String `tfsdk:"description"`
+	Members      types.List   `tfsdk:"members"`
+	Policies     types.List   `tfsdk:"policies"`
+}
@@ -0,0 +1,63 @@
+package datasource_policies
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func PoliciesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists all policies",
+		MarkdownDescription: "Lists all policies",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only policies whose ID starts with this prefix",
+				MarkdownDescription: "Return only policies whose ID starts with this prefix",
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching policies",
+				MarkdownDescription: "The matching policies",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the policy",
+							MarkdownDescription: "The name of the policy",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Unix Epoch in seconds",
+							MarkdownDescription: "Unix Epoch in seconds",
+						},
+						"statement": schema.StringAttribute{
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+							Description:         "A JSON string defining actions, resources, and effect",
+							MarkdownDescription: "A JSON string defining actions, resources, and effect",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type PoliciesModel struct {
+	Id       types.String  `tfsdk:"id"`
+	Prefix   types.String  `tfsdk:"prefix"`
+	Policies []PolicyModel `tfsdk:"policies"`
+}
+
+type PolicyModel struct {
+	Id           types.String         `tfsdk:"id"`
+	CreationDate types.Int64          `tfsdk:"creation_date"`
+	Statement    jsontypes.Normalized `tfsdk:"statement"`
+}
@@ -3,6 +3,7 @@ package datasource_policy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -16,6 +17,7 @@ func PolicyDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The name of the policy",
 			},
 			"statement": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
 				Computed:            true,
 				Description:         "A JSON string defining actions, resources, and effect",
 				MarkdownDescription: "A JSON string defining actions, resources, and effect",
@@ -30,7 +32,7 @@ func PolicyDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type PolicyModel struct {
-	Id           types.String `tfsdk:"id"`
-	Statement    types.String `tfsdk:"statement"`
-	CreationDate types.Int64  `tfsdk:"creation_date"`
+	Id           types.String         `tfsdk:"id"`
+	Statement    jsontypes.Normalized `tfsdk:"statement"`
+	CreationDate types.Int64          `tfsdk:"creation_date"`
 }
@@ -0,0 +1,110 @@
+package datasource_policy_document
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func PolicyDocumentDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Generates a LakeFS policy statement JSON document for use with lakefs_policy",
+		MarkdownDescription: "Generates a LakeFS policy statement JSON document for use with `lakefs_policy`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Hash of the generated JSON document",
+				MarkdownDescription: "Hash of the generated JSON document",
+			},
+			"json": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The canonical JSON statement list, suitable for the statement attribute of lakefs_policy",
+				MarkdownDescription: "The canonical JSON statement list, suitable for the `statement` attribute of `lakefs_policy`",
+			},
+			"source_policy_documents": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Policy statement JSON documents to merge. Statements from these documents come first, followed by the statement blocks",
+				MarkdownDescription: "Policy statement JSON documents to merge. Statements from these documents come first, followed by the `statement` blocks",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				Description:         "A policy statement",
+				MarkdownDescription: "A policy statement",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"effect": schema.StringAttribute{
+							Optional:            true,
+							Description:         "Whether the statement allows or denies the actions (allow or deny, defaults to allow)",
+							MarkdownDescription: "Whether the statement allows or denies the actions (`allow` or `deny`, defaults to `allow`)",
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"action": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							Description:         "The actions the statement applies to (e.g., fs:ReadObject, fs:*)",
+							MarkdownDescription: "The actions the statement applies to (e.g., `fs:ReadObject`, `fs:*`)",
+						},
+						"resource": schema.StringAttribute{
+							Required:            true,
+							Description:         "The resource ARN the statement applies to (e.g., arn:lakefs:fs:::repository/my-repo/*)",
+							MarkdownDescription: "The resource ARN the statement applies to (e.g., `arn:lakefs:fs:::repository/my-repo/*`)",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"condition": schema.ListNestedBlock{
+							Description:         "A condition that must hold for the statement to apply",
+							MarkdownDescription: "A condition that must hold for the statement to apply",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operator": schema.StringAttribute{
+										Required:            true,
+										Description:         "The condition operator (e.g., IpAddress)",
+										MarkdownDescription: "The condition operator (e.g., `IpAddress`)",
+									},
+									"key": schema.StringAttribute{
+										Required:            true,
+										Description:         "The context key the operator is evaluated against (e.g., SourceIp)",
+										MarkdownDescription: "The context key the operator is evaluated against (e.g., `SourceIp`)",
+									},
+									"values": schema.ListAttribute{
+										ElementType:         types.StringType,
+										Required:            true,
+										Description:         "The values to compare the context key against",
+										MarkdownDescription: "The values to compare the context key against",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type PolicyDocumentModel struct {
+	Id                    types.String     `tfsdk:"id"`
+	Json                  types.String     `tfsdk:"json"`
+	SourcePolicyDocuments types.List       `tfsdk:"source_policy_documents"`
+	Statement             []StatementModel `tfsdk:"statement"`
+}
+
+type StatementModel struct {
+	Effect    types.String     `tfsdk:"effect"`
+	Action    types.List       `tfsdk:"action"`
+	Resource  types.String     `tfsdk:"resource"`
+	Condition []ConditionModel `tfsdk:"condition"`
+}
+
+type ConditionModel struct {
+	Operator types.String `tfsdk:"operator"`
+	Key      types.String `tfsdk:"key"`
+	Values   types.List   `tfsdk:"values"`
+}
@@ -0,0 +1,100 @@
+package datasource_ref
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func RefDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Resolves any ref expression (branch, tag, commit ID, or expressions such as main~3 or main@) to the commit it points to",
+		MarkdownDescription: "Resolves any ref expression (branch, tag, commit ID, or expressions such as `main~3` or `main@`) to the commit it points to",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the resolved commit",
+				MarkdownDescription: "The ID of the resolved commit",
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the repository",
+				MarkdownDescription: "The name of the repository",
+			},
+			"ref": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ref expression to resolve",
+				MarkdownDescription: "The ref expression to resolve",
+			},
+			"kind": schema.StringAttribute{
+				Computed:            true,
+				Description:         "What the ref names: branch, tag or commit. Expressions such as main~3 are reported as commit",
+				MarkdownDescription: "What the ref names: `branch`, `tag` or `commit`. Expressions such as `main~3` are reported as `commit`",
+			},
+			"commit_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the resolved commit",
+				MarkdownDescription: "The ID of the resolved commit",
+			},
+			"committer": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The user that created the commit",
+				MarkdownDescription: "The user that created the commit",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The commit message",
+				MarkdownDescription: "The commit message",
+			},
+			"creation_date": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Unix Epoch in seconds",
+				MarkdownDescription: "Unix Epoch in seconds",
+			},
+			"meta_range_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The meta range ID of the commit",
+				MarkdownDescription: "The meta range ID of the commit",
+			},
+			"parents": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The IDs of the parent commits",
+				MarkdownDescription: "The IDs of the parent commits",
+			},
+			"metadata": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The commit metadata",
+				MarkdownDescription: "The commit metadata",
+			},
+			"generation": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The generation of the commit",
+				MarkdownDescription: "The generation of the commit",
+			},
+			"version": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The version of the commit format",
+				MarkdownDescription: "The version of the commit format",
+			},
+		},
+	}
+}
+
+type RefModel struct {
+	Id           types.String `tfsdk:"id"`
+	Repository   types.String `tfsdk:"repository"`
+	Ref          types.String `tfsdk:"ref"`
+	Kind         types.String `tfsdk:"kind"`
+	CommitId     types.String `tfsdk:"commit_id"`
+	Committer    types.String `tfsdk:"committer"`
+	Message      types.String `tfsdk:"message"`
+	CreationDate types.Int64  `tfsdk:"creation_date"`
+	MetaRangeId  types.String `tfsdk:"meta_range_id"`
+	Parents      types.List   `tfsdk:"parents"`
+	Metadata     types.Map    `tfsdk:"metadata"`
+	Generation   types.Int64  `tfsdk:"generation"`
+	Version      types.Int64  `tfsdk:"version"`
+}
@@ -0,0 +1,81 @@
+package datasource_repositories
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func RepositoriesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists all repositories, optionally filtered by prefix or search string",
+		MarkdownDescription: "Lists all repositories, optionally filtered by prefix or search string",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only repositories whose ID starts with this prefix",
+				MarkdownDescription: "Return only repositories whose ID starts with this prefix",
+			},
+			"search": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only repositories whose ID contains this string",
+				MarkdownDescription: "Return only repositories whose ID contains this string",
+			},
+			"repositories": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching repositories",
+				MarkdownDescription: "The matching repositories",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Unix Epoch in seconds",
+							MarkdownDescription: "Unix Epoch in seconds",
+						},
+						"default_branch": schema.StringAttribute{
+							Computed: true,
+						},
+						"read_only": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the repository is a read-only repository- not relevant for bare repositories",
+							MarkdownDescription: "Whether the repository is a read-only repository- not relevant for bare repositories",
+						},
+						"storage_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Unique identifier of the underlying data store. *EXPERIMENTAL*",
+							MarkdownDescription: "Unique identifier of the underlying data store. *EXPERIMENTAL*",
+						},
+						"storage_namespace": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Filesystem URI to store the underlying data in (e.g. \"s3://my-bucket/some/path/\")",
+							MarkdownDescription: "Filesystem URI to store the underlying data in (e.g. \"s3://my-bucket/some/path/\")",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type RepositoriesModel struct {
+	Id           types.String      `tfsdk:"id"`
+	Prefix       types.String      `tfsdk:"prefix"`
+	Search       types.String      `tfsdk:"search"`
+	Repositories []RepositoryModel `tfsdk:"repositories"`
+}
+
+type RepositoryModel struct {
+	Id               types.String `tfsdk:"id"`
+	CreationDate     types.Int64  `tfsdk:"creation_date"`
+	DefaultBranch    types.String `tfsdk:"default_branch"`
+	ReadOnly         types.Bool   `tfsdk:"read_only"`
+	StorageId        types.String `tfsdk:"storage_id"`
+	StorageNamespace types.String `tfsdk:"storage_namespace"`
+}
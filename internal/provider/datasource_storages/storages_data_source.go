@@ -0,0 +1,85 @@
+package datasource_storages
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func StoragesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the storage backends (blockstores) configured on the LakeFS server",
+		MarkdownDescription: "Lists the storage backends (blockstores) configured on the LakeFS server",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"storages": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The configured storages. Servers with a single blockstore return one storage with an empty ID",
+				MarkdownDescription: "The configured storages. Servers with a single blockstore return one storage with an empty ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The storage ID, used as storage_id of lakefs_repository",
+							MarkdownDescription: "The storage ID, used as `storage_id` of `lakefs_repository`",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Description of the storage",
+							MarkdownDescription: "Description of the storage",
+						},
+						"blockstore_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The blockstore type, such as s3, gs, azure or local",
+							MarkdownDescription: "The blockstore type, such as `s3`, `gs`, `azure` or `local`",
+						},
+						"namespace_example": schema.StringAttribute{
+							Computed:            true,
+							Description:         "An example storage namespace for the storage",
+							MarkdownDescription: "An example storage namespace for the storage",
+						},
+						"namespace_validity_regex": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The pattern storage namespaces must match",
+							MarkdownDescription: "The pattern storage namespaces must match",
+						},
+						"default_namespace_prefix": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The prefix under which repositories without a storage namespace are created",
+							MarkdownDescription: "The prefix under which repositories without a storage namespace are created",
+						},
+						"pre_sign_support": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the storage supports pre-signed URLs",
+							MarkdownDescription: "Whether the storage supports pre-signed URLs",
+						},
+						"import_support": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the storage supports importing data",
+							MarkdownDescription: "Whether the storage supports importing data",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type StoragesModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Storages []StorageModel `tfsdk:"storages"`
+}
+
+type StorageModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Description            types.String `tfsdk:"description"`
+	BlockstoreType         types.String `tfsdk:"blockstore_type"`
+	NamespaceExample       types.String `tfsdk:"namespace_example"`
+	NamespaceValidityRegex types.String `tfsdk:"namespace_validity_regex"`
+	DefaultNamespacePrefix types.String `tfsdk:"default_namespace_prefix"`
+	PreSignSupport         types.Bool   `tfsdk:"pre_sign_support"`
+	ImportSupport          types.Bool   `tfsdk:"import_support"`
+}
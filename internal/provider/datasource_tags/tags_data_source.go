@@ -0,0 +1,61 @@
+package datasource_tags
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TagsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists all tags of a repository",
+		MarkdownDescription: "Lists all tags of a repository",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "The repository to list tags of",
+				MarkdownDescription: "The repository to list tags of",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only tags whose name starts with this prefix",
+				MarkdownDescription: "Return only tags whose name starts with this prefix",
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching tags",
+				MarkdownDescription: "The matching tags",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the tag",
+							MarkdownDescription: "The name of the tag",
+						},
+						"commit_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The commit ID the tag points to",
+							MarkdownDescription: "The commit ID the tag points to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TagsModel struct {
+	Id         types.String `tfsdk:"id"`
+	Repository types.String `tfsdk:"repository"`
+	Prefix     types.String `tfsdk:"prefix"`
+	Tags       []TagModel   `tfsdk:"tags"`
+}
+
+type TagModel struct {
+	Id       types.String `tfsdk:"id"`
+	CommitId types.String `tfsdk:"commit_id"`
+}
@@ -20,6 +20,11 @@ func UserDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Unix Epoch in seconds",
 				MarkdownDescription: "Unix Epoch in seconds",
 			},
+			"email": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Email address of the user",
+				MarkdownDescription: "Email address of the user",
+			},
 			"friendly_name": schema.StringAttribute{
 				Computed:            true,
 				Description:         "Friendly name of the user",
@@ -32,5 +37,6 @@ func UserDataSourceSchema(ctx context.Context) schema.Schema {
 type UserModel struct {
 	Id           types.String `tfsdk:"id"`
 	CreationDate types.Int64  `tfsdk:"creation_date"`
+	Email        types.String `tfsdk:"email"`
 	FriendlyName types.String `tfsdk:"friendly_name"`
 }
@@ -0,0 +1,55 @@
+package datasource_user_credentials_list
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func UserCredentialsListDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the access keys of a user. Secrets are never returned",
+		MarkdownDescription: "Lists the access keys of a user. Secrets are never returned",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the user",
+				MarkdownDescription: "The ID of the user",
+			},
+			"credentials": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The access keys of the user",
+				MarkdownDescription: "The access keys of the user",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"access_key_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The access key ID",
+							MarkdownDescription: "The access key ID",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Unix Epoch in seconds",
+							MarkdownDescription: "Unix Epoch in seconds",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type UserCredentialsListModel struct {
+	Id          types.String      `tfsdk:"id"`
+	UserId      types.String      `tfsdk:"user_id"`
+	Credentials []CredentialModel `tfsdk:"credentials"`
+}
+
+type CredentialModel struct {
+	AccessKeyId  types.String `tfsdk:"access_key_id"`
+	CreationDate types.Int64  `tfsdk:"creation_date"`
+}
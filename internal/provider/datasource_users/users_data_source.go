@@ -0,0 +1,88 @@
+package datasource_users
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func UsersDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists all users together with their group memberships and policies",
+		MarkdownDescription: "Lists all users together with their group memberships and policies",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return only users whose ID starts with this prefix",
+				MarkdownDescription: "Return only users whose ID starts with this prefix",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching users",
+				MarkdownDescription: "The matching users",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The username of the user",
+							MarkdownDescription: "The username of the user",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Unix Epoch in seconds",
+							MarkdownDescription: "Unix Epoch in seconds",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Email address of the user",
+							MarkdownDescription: "Email address of the user",
+						},
+						"friendly_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Friendly name of the user",
+							MarkdownDescription: "Friendly name of the user",
+						},
+						"groups": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "IDs of the groups the user is a member of",
+							MarkdownDescription: "IDs of the groups the user is a member of",
+						},
+						"policies": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "IDs of the policies attached directly to the user",
+							MarkdownDescription: "IDs of the policies attached directly to the user",
+						},
+						"effective_policies": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "IDs of all policies that apply to the user, directly or through groups",
+							MarkdownDescription: "IDs of all policies that apply to the user, directly or through groups",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type UsersModel struct {
+	Id     types.String `tfsdk:"id"`
+	Prefix types.String `tfsdk:"prefix"`
+	Users  []UserModel  `tfsdk:"users"`
+}
+
+type UserModel struct {
+	Id                types.String `tfsdk:"id"`
+	CreationDate      types.Int64  `tfsdk:"creation_date"`
+	Email             types.String `tfsdk:"email"`
+	FriendlyName      types.String `tfsdk:"friendly_name"`
+	Groups            types.List   `tfsdk:"groups"`
+	Policies          types.List   `tfsdk:"policies"`
+	EffectivePolicies types.List   `tfsdk:"effective_policies"`
+}
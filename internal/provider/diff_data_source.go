@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_diff"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DiffDataSource{}
+
+func NewDiffDataSource() datasource.DataSource {
+	return &DiffDataSource{}
+}
+
+// DiffDataSource defines the data source implementation.
+type DiffDataSource struct {
+	dataSourceBase
+}
+
+func (d *DiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diff"
+}
+
+func (d *DiffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_diff.DiffDataSourceSchema(ctx)
+}
+
+func (d *DiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_diff.DiffModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	repository := data.Repository.ValueString()
+	leftRef := data.LeftRef.ValueString()
+
+	var typeFilter map[string]bool
+	if !data.Types.IsNull() {
+		var changeTypes []string
+		resp.Diagnostics.Append(data.Types.ElementsAs(ctx, &changeTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		typeFilter = make(map[string]bool, len(changeTypes))
+		for _, t := range changeTypes {
+			typeFilter[t] = true
+		}
+	}
+
+	var results []lakefsapi.Diff
+	var err error
+	if data.RightRef.IsNull() {
+		data.Id = types.StringValue(fmt.Sprintf("%s/%s", repository, leftRef))
+		results, err = client.diffBranch(ctx, repository, leftRef, lakefsapi.DiffBranchParams{
+			Prefix:    optional(data.Prefix.ValueString()),
+			Delimiter: optional(data.Delimiter.ValueString()),
+		})
+	} else {
+		rightRef := data.RightRef.ValueString()
+		data.Id = types.StringValue(fmt.Sprintf("%s/%s...%s", repository, leftRef, rightRef))
+		results, err = client.diffRefs(ctx, repository, leftRef, rightRef, lakefsapi.DiffRefsParams{
+			Prefix:    optional(data.Prefix.ValueString()),
+			Delimiter: optional(data.Delimiter.ValueString()),
+			Type:      optional(lakefsapi.DiffRefsParamsType(data.DiffType.ValueString())),
+		})
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read diff", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Read diff", map[string]any{
+		"repository": repository,
+		"id":         data.Id.ValueString(),
+		"entries":    len(results),
+	})
+
+	entries := filterDiffEntries(results, typeFilter)
+
+	data.Entries = make([]datasource_diff.EntryModel, 0, len(entries))
+	for _, entry := range entries {
+		data.Entries = append(data.Entries, datasource_diff.EntryModel{
+			Path:      types.StringValue(entry.Path),
+			Type:      types.StringValue(string(entry.Type)),
+			PathType:  types.StringValue(string(entry.PathType)),
+			SizeBytes: types.Int64PointerValue(entry.SizeBytes),
+		})
+	}
+
+	summary, diags := types.ObjectValueFrom(ctx, datasource_diff.SummaryAttrTypes(), summarizeDiff(entries))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Summary = summary
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterDiffEntries returns the entries whose type is in typeFilter, or all
+// entries when typeFilter is nil
+func filterDiffEntries(entries []lakefsapi.Diff, typeFilter map[string]bool) []lakefsapi.Diff {
+	if typeFilter == nil {
+		return entries
+	}
+
+	filtered := make([]lakefsapi.Diff, 0, len(entries))
+	for _, entry := range entries {
+		if typeFilter[string(entry.Type)] {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// summarizeDiff counts the entries per change type
+func summarizeDiff(entries []lakefsapi.Diff) datasource_diff.SummaryModel {
+	counts := make(map[string]int64)
+	for _, entry := range entries {
+		counts[string(entry.Type)]++
+	}
+
+	return datasource_diff.SummaryModel{
+		Added:         types.Int64Value(counts["added"]),
+		Removed:       types.Int64Value(counts["removed"]),
+		Changed:       types.Int64Value(counts["changed"]),
+		Conflict:      types.Int64Value(counts["conflict"]),
+		PrefixChanged: types.Int64Value(counts["prefix_changed"]),
+		Total:         types.Int64Value(int64(len(entries))),
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+func TestSummarizeDiff(t *testing.T) {
+	entries := []lakefsapi.Diff{
+		{Type: "added", Path: "tables/orders/a.parquet"},
+		{Type: "removed", Path: "tables/orders/b.parquet"},
+		{Type: "removed", Path: "tables/orders/c.parquet"},
+		{Type: "changed", Path: "tables/customers/"},
+	}
+
+	summary := summarizeDiff(filterDiffEntries(entries, map[string]bool{"removed": true, "changed": true}))
+
+	if !summary.Removed.Equal(types.Int64Value(2)) {
+		t.Errorf("expected 2 removed entries, got %s", summary.Removed)
+	}
+	if !summary.Changed.Equal(types.Int64Value(1)) {
+		t.Errorf("expected 1 changed entry, got %s", summary.Changed)
+	}
+	if !summary.Added.Equal(types.Int64Value(0)) {
+		t.Errorf("expected no added entries, got %s", summary.Added)
+	}
+	if !summary.Total.Equal(types.Int64Value(3)) {
+		t.Errorf("expected 3 entries in total, got %s", summary.Total)
+	}
+
+	if got := filterDiffEntries(entries, nil); len(got) != len(entries) {
+		t.Errorf("expected all %d entries without a filter, got %d", len(entries), len(got))
+	}
+}
@@ -0,0 +1,46 @@
+package ephemeral_credentials
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CredentialsEphemeralResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Creates a short-lived access key pair that is deleted again at the end of the Terraform run. The secret never lands in state or plan files",
+		MarkdownDescription: "Creates a short-lived access key pair that is deleted again at the end of the Terraform run. The secret never lands in state or plan files",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the user to create the credentials for (defaults to the user the provider authenticates as)",
+				MarkdownDescription: "The ID of the user to create the credentials for (defaults to the user the provider authenticates as)",
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The access key ID",
+				MarkdownDescription: "The access key ID",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The secret access key",
+				MarkdownDescription: "The secret access key",
+			},
+			"creation_date": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Unix Epoch in seconds",
+				MarkdownDescription: "Unix Epoch in seconds",
+			},
+		},
+	}
+}
+
+type CredentialsModel struct {
+	UserId          types.String `tfsdk:"user_id"`
+	AccessKeyId     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	CreationDate    types.Int64  `tfsdk:"creation_date"`
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,7 +18,7 @@ func NewGroupDataSource() datasource.DataSource {
 
 // GroupDataSource defines the data source implementation.
 type GroupDataSource struct {
-	client *LakeFSClient
+	dataSourceBase
 }
 
 func (d *GroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -30,23 +29,6 @@ func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 	resp.Schema = datasource_group.GroupDataSourceSchema(ctx)
 }
 
-func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
-}
-
 func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data datasource_group.GroupModel
 
@@ -55,19 +37,23 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(checkServerRequirement(ctx, d.client, "lakefs_group", serverRequirement{RBAC: true})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	client := NewAPIClient(d.client)
 
-	var result GroupResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/groups/%s", data.Id.ValueString()), &result)
+	result, err := client.GetGroupWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group: %s", err))
+		addClientError(&resp.Diagnostics, "read group", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	if result.Description != "" {
-		data.Description = types.StringValue(result.Description)
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	if result.JSON200.Description != "" {
+		data.Description = types.StringValue(result.JSON200.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
@@ -2,18 +2,19 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_group_membership"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupMembershipResource{}
 var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+var _ resource.ResourceWithModifyPlan = &GroupMembershipResource{}
 
 func NewGroupMembershipResource() resource.Resource {
 	return &GroupMembershipResource{}
@@ -21,7 +22,7 @@ func NewGroupMembershipResource() resource.Resource {
 
 // GroupMembershipResource defines the resource implementation.
 type GroupMembershipResource struct {
-	client *LakeFSClient
+	resourceBase
 }
 
 func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -32,21 +33,14 @@ func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = resource_group_membership.GroupMembershipResourceSchema(ctx)
 }
 
-func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ModifyPlan reports servers without RBAC, which cannot manage group memberships
+func (r *GroupMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
+	resp.Diagnostics.Append(checkServerRequirement(ctx, r.client, "lakefs_group_membership", serverRequirement{RBAC: true})...)
 }
 
 func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -57,11 +51,20 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	err := client.Put(ctx, fmt.Sprintf("/auth/groups/%s/members/%s", data.GroupId.ValueString(), data.UserId.ValueString()), nil, nil)
+	_, err := client.AddGroupMembershipWithResponse(ctx, data.GroupId.ValueString(), data.UserId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add user to group: %s", err))
+		addClientError(&resp.Diagnostics, "add user to group", err)
 		return
 	}
 
@@ -78,27 +81,27 @@ func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	// List all members of the group and check if the user is a member
-	var result struct {
-		Results []struct {
-			ID string `json:"id"`
-		} `json:"results"`
-	}
-	err := client.Get(ctx, fmt.Sprintf("/auth/groups/%s/members", data.GroupId.ValueString()), &result)
+	members, err := client.listGroupMembers(ctx, data.GroupId.ValueString(), lakefsapi.ListGroupMembersParams{})
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group membership: %s", err))
+		readFailed(ctx, resp, "read group membership", err)
 		return
 	}
 
 	// Check if the user is in the members list
 	found := false
-	for _, member := range result.Results {
+	for _, member := range members {
 		if member.ID == data.UserId.ValueString() {
 			found = true
 			break
@@ -125,11 +128,20 @@ func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	err := client.Delete(ctx, fmt.Sprintf("/auth/groups/%s/members/%s", data.GroupId.ValueString(), data.UserId.ValueString()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user from group: %s", err))
+	_, err := client.DeleteGroupMembershipWithResponse(ctx, data.GroupId.ValueString(), data.UserId.ValueString())
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, "remove user from group", err)
 		return
 	}
 }
@@ -13,6 +13,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupPolicyAttachmentResource{}
 var _ resource.ResourceWithImportState = &GroupPolicyAttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &GroupPolicyAttachmentResource{}
 
 func NewGroupPolicyAttachmentResource() resource.Resource {
 	return &GroupPolicyAttachmentResource{}
@@ -20,7 +21,7 @@ func NewGroupPolicyAttachmentResource() resource.Resource {
 
 // GroupPolicyAttachmentResource defines the resource implementation.
 type GroupPolicyAttachmentResource struct {
-	client *LakeFSClient
+	resourceBase
 }
 
 func (r *GroupPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -31,21 +32,14 @@ func (r *GroupPolicyAttachmentResource) Schema(ctx context.Context, req resource
 	resp.Schema = resource_group_policy_attachment.GroupPolicyAttachmentResourceSchema(ctx)
 }
 
-func (r *GroupPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ModifyPlan reports servers without RBAC, which cannot attach policies
+func (r *GroupPolicyAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
+	resp.Diagnostics.Append(checkServerRequirement(ctx, r.client, "lakefs_group_policy_attachment", serverRequirement{RBAC: true})...)
 }
 
 func (r *GroupPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -56,13 +50,22 @@ func (r *GroupPolicyAttachmentResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	groupID := data.GroupId.ValueString()
 	policyID := data.PolicyId.ValueString()
 
-	err := client.Put(ctx, fmt.Sprintf("/auth/groups/%s/policies/%s", groupID, policyID), nil, nil)
+	_, err := client.AttachPolicyToGroupWithResponse(ctx, groupID, policyID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach policy %s to group %s: %s", policyID, groupID, err))
+		addClientError(&resp.Diagnostics, fmt.Sprintf("attach policy %s to group %s", policyID, groupID), err)
 		return
 	}
 
@@ -77,19 +80,23 @@ func (r *GroupPolicyAttachmentResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	groupID := data.GroupId.ValueString()
 	policyID := data.PolicyId.ValueString()
 
 	// Check if policy is still attached
-	var result PolicyResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/groups/%s/policies/%s", groupID, policyID), &result)
+	_, err := client.GetGroupPolicyWithResponse(ctx, groupID, policyID)
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group policy attachment: %s", err))
+		readFailed(ctx, resp, "read group policy attachment", err)
 		return
 	}
 
@@ -108,13 +115,22 @@ func (r *GroupPolicyAttachmentResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	groupID := data.GroupId.ValueString()
 	policyID := data.PolicyId.ValueString()
 
-	err := client.Delete(ctx, fmt.Sprintf("/auth/groups/%s/policies/%s", groupID, policyID))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach policy %s from group %s: %s", policyID, groupID, err))
+	_, err := client.DetachPolicyFromGroupWithResponse(ctx, groupID, policyID)
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("detach policy %s from group %s", policyID, groupID), err)
 		return
 	}
 }
@@ -2,19 +2,20 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_group"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithModifyPlan = &GroupResource{}
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
@@ -22,20 +23,7 @@ func NewGroupResource() resource.Resource {
 
 // GroupResource defines the resource implementation.
 type GroupResource struct {
-	client *LakeFSClient
-}
-
-// GroupCreateRequest represents the request to create a group
-type GroupCreateRequest struct {
-	ID          string `json:"id"`
-	Description string `json:"description,omitempty"`
-}
-
-// GroupResponse represents the API response for a group
-type GroupResponse struct {
-	ID           string `json:"id"`
-	CreationDate int64  `json:"creation_date"`
-	Description  string `json:"description,omitempty"`
+	resourceBase
 }
 
 func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,21 +34,14 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = resource_group.GroupResourceSchema(ctx)
 }
 
-func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ModifyPlan reports servers without RBAC, which cannot manage groups
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
+	resp.Diagnostics.Append(checkServerRequirement(ctx, r.client, "lakefs_group", serverRequirement{RBAC: true})...)
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -71,9 +52,18 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	createReq := GroupCreateRequest{
+	createReq := lakefsapi.GroupCreation{
 		ID: data.Id.ValueString(),
 	}
 
@@ -81,17 +71,16 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		createReq.Description = data.Description.ValueString()
 	}
 
-	var result GroupResponse
-	err := client.Post(ctx, "/auth/groups", createReq, &result)
+	result, err := client.CreateGroupWithResponse(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group: %s", err))
+		addClientError(&resp.Diagnostics, "create group", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	if result.Description != "" {
-		data.Description = types.StringValue(result.Description)
+	data.Id = types.StringValue(result.JSON201.ID)
+	data.CreationDate = types.Int64Value(result.JSON201.CreationDate)
+	if result.JSON201.Description != "" {
+		data.Description = types.StringValue(result.JSON201.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
@@ -109,23 +98,27 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	var result GroupResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/groups/%s", data.Id.ValueString()), &result)
+	result, err := client.GetGroupWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group: %s", err))
+		readFailed(ctx, resp, "read group", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	if result.Description != "" {
-		data.Description = types.StringValue(result.Description)
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	if result.JSON200.Description != "" {
+		data.Description = types.StringValue(result.JSON200.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
@@ -134,7 +127,15 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Groups in lakeFS don't have many updatable fields via this resource currently.
+	var data resource_group.GroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API has no group update endpoint - only the timeouts change in place
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -145,11 +146,20 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	err := client.Delete(ctx, fmt.Sprintf("/auth/groups/%s", data.Id.ValueString()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group: %s", err))
+	_, err := client.DeleteGroupWithResponse(ctx, data.Id.ValueString())
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, "delete group", err)
 		return
 	}
 }
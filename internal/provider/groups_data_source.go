@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_groups"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupsDataSource defines the data source implementation.
+type GroupsDataSource struct {
+	dataSourceBase
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_groups.GroupsDataSourceSchema(ctx)
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_groups.GroupsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkServerRequirement(ctx, d.client, "lakefs_groups", serverRequirement{RBAC: true})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	results, err := client.listGroups(ctx, lakefsapi.ListGroupsParams{Prefix: optional(data.Prefix.ValueString())})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list groups", err)
+		return
+	}
+
+	data.Id = types.StringValue("groups")
+	data.Groups = make([]datasource_groups.GroupModel, 0, len(results))
+	for _, group := range results {
+		members, err := client.listGroupMembers(ctx, group.ID, lakefsapi.ListGroupMembersParams{})
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("list members of group %s", group.ID), err)
+			return
+		}
+
+		policies, err := client.listGroupPolicies(ctx, group.ID, lakefsapi.ListGroupPoliciesParams{})
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("list policies of group %s", group.ID), err)
+			return
+		}
+
+		model := datasource_groups.GroupModel{
+			Id:           types.StringValue(group.ID),
+			CreationDate: types.Int64Value(group.CreationDate),
+			Description:  optionalStringValue(group.Description),
+		}
+		model.Members = idListValue(ctx, userIDs(members), &resp.Diagnostics)
+		model.Policies = idListValue(ctx, policyIDs(policies), &resp.Diagnostics)
+
+		data.Groups = append(data.Groups, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_import"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ImportResource{}
+var _ resource.ResourceWithModifyPlan = &ImportResource{}
+
+func NewImportResource() resource.Resource {
+	return &ImportResource{}
+}
+
+// ImportResource defines the resource implementation.
+type ImportResource struct {
+	resourceBase
+}
+
+const (
+	// importTimeout is the default create timeout, which bounds how long
+	// Create waits for an import to complete
+	importTimeout = 60 * time.Minute
+	// importPollInterval is the delay between import status requests
+	importPollInterval = 5 * time.Second
+)
+
+func (r *ImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import"
+}
+
+func (r *ImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_import.ImportResourceSchema(ctx)
+}
+
+// ModifyPlan reports servers that cannot import from their blockstore, or
+// that predate the asynchronous import endpoints added in lakeFS 0.102.0
+func (r *ImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkServerRequirement(ctx, r.client, "lakefs_import", serverRequirement{MinVersion: "0.102.0", Import: true})...)
+}
+
+func (r *ImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_import.ImportModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, importTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := NewAPIClient(r.client)
+
+	var sources []resource_import.SourceModel
+	resp.Diagnostics.Append(data.Sources.ElementsAs(ctx, &sources, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := lakefsapi.ImportCreation{
+		Commit: lakefsapi.CommitCreation{Message: data.CommitMessage.ValueString()},
+	}
+	for _, source := range sources {
+		createReq.Paths = append(createReq.Paths, lakefsapi.ImportLocation{
+			Type:        lakefsapi.ImportLocationType(source.Type.ValueString()),
+			Path:        source.Path.ValueString(),
+			Destination: source.Destination.ValueString(),
+		})
+	}
+	if !data.CommitMetadata.IsNull() {
+		resp.Diagnostics.Append(data.CommitMetadata.ElementsAs(ctx, &createReq.Commit.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	repository := data.Repository.ValueString()
+	branch := data.Branch.ValueString()
+
+	tflog.Debug(ctx, "Starting import", map[string]any{
+		"repository": repository,
+		"branch":     branch,
+		"sources":    len(createReq.Paths),
+	})
+
+	started, err := client.ImportStartWithResponse(ctx, repository, branch, createReq)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "start import", err)
+		return
+	}
+
+	status, err := waitForImport(ctx, client, repository, branch, started.JSON202.ID, importPollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Import %s did not complete: %s", started.JSON202.ID, err))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(started.JSON202.ID)
+	data.CommitId = types.StringValue(status.Commit.ID)
+	data.MetarangeId = types.StringValue(status.MetarangeID)
+	data.IngestedObjects = types.Int64Value(status.IngestedObjects)
+
+	tflog.Trace(ctx, "Completed import", map[string]any{
+		"id":               started.JSON202.ID,
+		"commit_id":        status.Commit.ID,
+		"ingested_objects": status.IngestedObjects,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_import.ImportModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := NewAPIClient(r.client)
+
+	// Import jobs are not kept forever, but the commit they created is
+	_, err := client.GetCommitWithResponse(ctx, data.Repository.ValueString(), data.CommitId.ValueString())
+	if err != nil {
+		readFailed(ctx, resp, "read import commit", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_import.ImportModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument requires replacement, so there is nothing to update
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_import.ImportModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// The import commit is part of the branch history and is left in place
+	tflog.Debug(ctx, "Removing import from state", map[string]any{
+		"id":        data.Id.ValueString(),
+		"commit_id": data.CommitId.ValueString(),
+	})
+}
+
+// waitForImport polls the status of an import until it completes, fails or
+// ctx is done. An import still running when ctx is done is canceled.
+func waitForImport(ctx context.Context, client *APIClient, repository, branch, id string, interval time.Duration) (*lakefsapi.ImportStatus, error) {
+	params := &lakefsapi.ImportStatusParams{ID: id}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := client.ImportStatusWithResponse(ctx, repository, branch, params)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, cancelImport(ctx, client, repository, branch, id)
+			}
+			return nil, err
+		}
+		status := result.JSON200
+
+		if status.Error != nil {
+			return nil, errors.New(status.Error.Message)
+		}
+		if status.Completed {
+			if status.Commit == nil {
+				return nil, errors.New("import completed without a commit")
+			}
+			return status, nil
+		}
+
+		tflog.Info(ctx, "Import in progress", map[string]any{
+			"id":               id,
+			"ingested_objects": status.IngestedObjects,
+			"update_time":      status.UpdateTime,
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, cancelImport(ctx, client, repository, branch, id)
+		case <-ticker.C:
+		}
+	}
+}
+
+// cancelImport cancels a running import after ctx is done and returns the
+// error describing why the wait ended
+func cancelImport(ctx context.Context, client *APIClient, repository, branch, id string) error {
+	tflog.Warn(ctx, "Canceling import", map[string]any{
+		"id": id,
+	})
+
+	// ctx is done, so the cancellation needs a context of its own
+	cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+
+	_, err := client.ImportCancelWithResponse(cancelCtx, repository, branch, &lakefsapi.ImportCancelParams{ID: id})
+	if err := IgnoreNotFound(err); err != nil {
+		return fmt.Errorf("%w (canceling the import also failed: %s)", ctx.Err(), err)
+	}
+	return fmt.Errorf("%w, the import was canceled", ctx.Err())
+}
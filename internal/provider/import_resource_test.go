@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+func TestWaitForImportPollsUntilCompleted(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") != "imp1" {
+			t.Errorf("unexpected import id: %s", r.URL.RawQuery)
+		}
+
+		status := lakefsapi.ImportStatus{IngestedObjects: 10}
+		if polls.Add(1) == 3 {
+			status.Completed = true
+			status.IngestedObjects = 42
+			status.Commit = &lakefsapi.Commit{ID: "c1"}
+		}
+		testWriteJSON(w, http.StatusOK, status)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	status, err := waitForImport(context.Background(), client, "repo", "main", "imp1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if status.Commit.ID != "c1" || status.IngestedObjects != 42 {
+		t.Errorf("unexpected status: %+v", status)
+	}
+	if polls.Load() != 3 {
+		t.Errorf("expected 3 polls, got %d", polls.Load())
+	}
+}
+
+func TestWaitForImportCancelsOnTimeout(t *testing.T) {
+	var canceled atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			canceled.Store(true)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testWriteJSON(w, http.StatusOK, lakefsapi.ImportStatus{})
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := waitForImport(ctx, client, "repo", "main", "imp1", time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline exceeded error, got: %v", err)
+	}
+	if !canceled.Load() {
+		t.Error("expected the import to be canceled")
+	}
+}
+
+func TestWaitForImportReportsFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testWriteJSON(w, http.StatusOK, json.RawMessage(`{"completed":false,"error":{"message":"access denied"}}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	_, err := waitForImport(context.Background(), client, "repo", "main", "imp1", time.Millisecond)
+	if err == nil || err.Error() != "access denied" {
+		t.Errorf("expected the import error, got: %v", err)
+	}
+}
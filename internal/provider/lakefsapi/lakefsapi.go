@@ -0,0 +1,131 @@
+// Package lakefsapi is a typed client for the LakeFS API. Its models and
+// operations are generated by oapi-codegen from api/swagger.yml, limited to the
+// operations listed in oapi-codegen.yaml, so that requests and responses cover
+// every field the spec defines.
+package lakefsapi
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config oapi-codegen.yaml ../../../api/swagger.yml
+
+// Operation is an operation of the API spec
+type Operation struct {
+	// ID is the operationId of the operation in the spec
+	ID string
+	// PathTemplate is the path of the operation, with placeholders for its
+	// path parameters, such as /repositories/{repository}
+	PathTemplate string
+
+	method    string
+	segments  []string
+	responses *openapi3.Responses
+}
+
+// operations returns the operations of the embedded spec
+var operations = sync.OnceValue(func() []Operation {
+	spec, err := GetSwagger()
+	if err != nil {
+		// The spec is embedded by the generator, which already parsed it
+		panic(fmt.Sprintf("lakefsapi: invalid embedded spec: %s", err))
+	}
+
+	var ops []Operation
+	for template, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			ops = append(ops, Operation{
+				// The generator capitalizes the operation IDs, which LakeFS
+				// writes in lower camel case
+				ID:           strings.ToLower(op.OperationID[:1]) + op.OperationID[1:],
+				PathTemplate: template,
+				method:       method,
+				segments:     strings.Split(template, "/"),
+				responses:    op.Responses,
+			})
+		}
+	}
+	return ops
+})
+
+// FindOperation returns the operation a request is sent for, where path is the
+// escaped request path relative to the API endpoint. Literal path segments
+// take precedence over path parameters, as in the LakeFS server.
+func FindOperation(method, path string) (Operation, bool) {
+	segments := strings.Split(path, "/")
+
+	var found Operation
+	best := -1
+	for _, op := range operations() {
+		if op.method != method || len(op.segments) != len(segments) {
+			continue
+		}
+
+		literals := 0
+		for i, segment := range op.segments {
+			if strings.HasPrefix(segment, "{") {
+				if segments[i] == "" {
+					literals = -1
+					break
+				}
+				continue
+			}
+			if segment != segments[i] {
+				literals = -1
+				break
+			}
+			literals++
+		}
+
+		if literals > best {
+			found, best = op, literals
+		}
+	}
+	return found, best >= 0
+}
+
+// CheckResponse returns an error if the spec does not describe a successful
+// response with status and contentType, which the typed client could not
+// decode. It leaves the body of such a response unset rather than failing.
+func (o Operation) CheckResponse(status int, contentType string) error {
+	response := o.responses.Status(status)
+	if response == nil || response.Value == nil {
+		return fmt.Errorf("unexpected response status %d to %s", status, o.ID)
+	}
+	if response.Value.Content.Get("application/json") != nil && !strings.Contains(contentType, "json") {
+		return fmt.Errorf("unexpected response content type %q to %s", contentType, o.ID)
+	}
+	return nil
+}
+
+// PageSize is the number of results requested per page by ListAll
+const PageSize = 1000
+
+// ListAll calls list for every page, starting with the first, until there
+// are no more results. It fails when the server returns the same offset again
+// rather than requesting that page forever.
+func ListAll[T any](list func(after string, amount int) ([]T, Pagination, error)) ([]T, error) {
+	var all []T
+
+	after := ""
+	for {
+		results, pagination, err := list(after, PageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, results...)
+
+		if !pagination.HasMore || pagination.NextOffset == "" {
+			return all, nil
+		}
+		if pagination.NextOffset == after {
+			return nil, fmt.Errorf("pagination did not advance past offset %q", after)
+		}
+		after = pagination.NextOffset
+	}
+}
@@ -0,0 +1,177 @@
+package lakefsapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testDoer records requests, and answers each with the next response body
+type testDoer struct {
+	requests  []*http.Request
+	responses []string
+}
+
+func (d *testDoer) Do(req *http.Request) (*http.Response, error) {
+	d.requests = append(d.requests, req)
+	if len(d.responses) == 0 {
+		return nil, errors.New("unexpected request")
+	}
+	body := d.responses[0]
+	d.responses = d.responses[1:]
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func testClient(t *testing.T, doer *testDoer) *ClientWithResponses {
+	t.Helper()
+
+	client, err := NewClientWithResponses("http://lakefs/api/v1", WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestPathParametersAreEscaped(t *testing.T) {
+	doer := &testDoer{responses: []string{`{"id":"feature/x","commit_id":"c1"}`}}
+	client := testClient(t, doer)
+
+	resp, err := client.GetBranchWithResponse(context.Background(), "repo", "feature/x")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ref := resp.JSON200; ref.ID != "feature/x" || ref.CommitID != "c1" {
+		t.Errorf("unexpected branch: %+v", ref)
+	}
+
+	if path := doer.requests[0].URL.EscapedPath(); path != "/api/v1/repositories/repo/branches/feature%2Fx" {
+		t.Errorf("unexpected path: %s", path)
+	}
+}
+
+func TestQueryParametersOmitZeroValues(t *testing.T) {
+	doer := &testDoer{responses: []string{`{}`, `{}`}}
+	client := testClient(t, doer)
+	ctx := context.Background()
+
+	if _, err := client.LogCommitsWithResponse(ctx, "repo", "main", &LogCommitsParams{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.LogCommitsWithResponse(ctx, "repo", "main", &LogCommitsParams{
+		Amount:  ptr(2),
+		Limit:   ptr(true),
+		Objects: &[]string{"a.txt", "b.txt"},
+		StopAt:  ptr("v1"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if query := doer.requests[0].URL.RawQuery; query != "" {
+		t.Errorf("unexpected query without parameters: %s", query)
+	}
+
+	expected := url.Values{
+		"amount":  {"2"},
+		"limit":   {"true"},
+		"objects": {"a.txt", "b.txt"},
+		"stop_at": {"v1"},
+	}
+	if query := doer.requests[1].URL.Query(); !reflect.DeepEqual(query, expected) {
+		t.Errorf("unexpected query: %s", doer.requests[1].URL.RawQuery)
+	}
+}
+
+func TestFindOperation(t *testing.T) {
+	tests := []struct {
+		method, path string
+		id, template string
+	}{
+		{http.MethodGet, "/repositories/repo/branches/feature%2Fx", "getBranch", "/repositories/{repository}/branches/{branch}"},
+		{http.MethodPost, "/repositories/repo/branches/main/import", "importStart", "/repositories/{repository}/branches/{branch}/import"},
+		{http.MethodGet, "/auth/users", "listUsers", "/auth/users"},
+		{http.MethodGet, "/user", "getCurrentUser", "/user"},
+	}
+
+	for _, test := range tests {
+		op, ok := FindOperation(test.method, test.path)
+		if !ok || op.ID != test.id || op.PathTemplate != test.template {
+			t.Errorf("FindOperation(%s, %s) = %+v, %t, expected %s", test.method, test.path, op, ok, test.id)
+		}
+	}
+
+	for _, path := range []string{"/repositories//branches/main", "/unknown", "/repositories/repo/branches/main/unknown"} {
+		if op, ok := FindOperation(http.MethodGet, path); ok {
+			t.Errorf("expected no operation for %s, got %s", path, op.ID)
+		}
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	getBranch, _ := FindOperation(http.MethodGet, "/repositories/repo/branches/main")
+	createBranch, _ := FindOperation(http.MethodPost, "/repositories/repo/branches")
+
+	if err := getBranch.CheckResponse(http.StatusOK, "application/json"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := getBranch.CheckResponse(http.StatusOK, "text/html"); err == nil {
+		t.Error("expected an error for a response without a JSON body")
+	}
+	if err := getBranch.CheckResponse(http.StatusCreated, "application/json"); err == nil {
+		t.Error("expected an error for an undocumented status")
+	}
+	if err := createBranch.CheckResponse(http.StatusCreated, "text/html"); err != nil {
+		t.Errorf("unexpected error for a text response: %s", err)
+	}
+}
+
+func TestListAllFollowsPagination(t *testing.T) {
+	pages := []Pagination{{HasMore: true, NextOffset: "u2"}, {}}
+	results := [][]string{{"u1", "u2"}, {"u3"}}
+
+	var afters []string
+	all, err := ListAll(func(after string, amount int) ([]string, Pagination, error) {
+		if amount != PageSize {
+			t.Errorf("unexpected page size %d", amount)
+		}
+		i := len(afters)
+		afters = append(afters, after)
+		return results[i], pages[i], nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(all, []string{"u1", "u2", "u3"}) {
+		t.Errorf("unexpected results: %v", all)
+	}
+	if !reflect.DeepEqual(afters, []string{"", "u2"}) {
+		t.Errorf("unexpected offsets: %v", afters)
+	}
+}
+
+func TestListAllFailsWhenOffsetDoesNotAdvance(t *testing.T) {
+	var calls int
+	_, err := ListAll(func(after string, amount int) ([]string, Pagination, error) {
+		calls++
+		return []string{"b1"}, Pagination{HasMore: true, NextOffset: "b1"}, nil
+	})
+	if err == nil || err.Error() != `pagination did not advance past offset "b1"` {
+		t.Fatalf("expected an error, got: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 pages, got %d", calls)
+	}
+}
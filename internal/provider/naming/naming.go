@@ -0,0 +1,109 @@
+// Package naming holds the LakeFS naming rules for repositories, branches and
+// refs, shared by schema validators and provider functions.
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// RepositoryPattern matches valid repository names
+	RepositoryPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{2,62}$`)
+	// BranchPattern matches valid branch names
+	BranchPattern = regexp.MustCompile(`^\w[-\w]*$`)
+	// AuthIDPattern matches valid user, group and policy IDs
+	AuthIDPattern = regexp.MustCompile(`^[\w+=,.@-]+$`)
+
+	// repositoryWildcardPattern and branchWildcardPattern match policy
+	// resource patterns that use the * and ? wildcards
+	repositoryWildcardPattern = regexp.MustCompile(`^[a-z0-9*?-]+$`)
+	branchWildcardPattern     = regexp.MustCompile(`^[-\w*?]+$`)
+)
+
+// ValidateRepositoryName returns an error if name is not a valid repository name
+func ValidateRepositoryName(name string) error {
+	if !RepositoryPattern.MatchString(name) {
+		return fmt.Errorf("invalid repository name %q: must be 3 to 63 lowercase letters, digits and hyphens, starting with a letter or digit", name)
+	}
+	return nil
+}
+
+// ValidateBranchName returns an error if name is not a valid branch name
+func ValidateBranchName(name string) error {
+	if !BranchPattern.MatchString(name) {
+		return fmt.Errorf("invalid branch name %q: must consist of letters, digits, underscores and hyphens, and must not start with a hyphen", name)
+	}
+	return nil
+}
+
+// ValidateTagName returns an error if name is not a valid tag name. Tags
+// follow the git ref naming rules.
+func ValidateTagName(name string) error {
+	invalid := func(reason string) error {
+		return fmt.Errorf("invalid tag name %q: %s", name, reason)
+	}
+
+	switch {
+	case name == "":
+		return fmt.Errorf("tag name must not be empty")
+	case name == "@":
+		return invalid("must not be @")
+	case strings.HasSuffix(name, "/"), strings.HasSuffix(name, "."), strings.HasSuffix(name, ".lock"):
+		return invalid("must not end with /, . or .lock")
+	case strings.Contains(name, ".."), strings.Contains(name, "//"), strings.Contains(name, "@{"):
+		return invalid("must not contain .., // or @{")
+	}
+
+	for _, c := range name {
+		if c < 0x20 || c == 0x7f || strings.ContainsRune(" ~^:?*[\\", c) {
+			return invalid("must not contain control characters, spaces or any of ~^:?*[\\")
+		}
+	}
+	return nil
+}
+
+// ValidateAuthID returns an error if id is not a valid user, group or policy ID
+func ValidateAuthID(id string) error {
+	if !AuthIDPattern.MatchString(id) {
+		return fmt.Errorf("invalid ID %q: must consist of letters, digits and any of _+=,.@-", id)
+	}
+	return nil
+}
+
+// ValidateRef returns an error if ref cannot be used as the ref of a LakeFS
+// URI. Refs are branches, tags, commit IDs or ref expressions such as main~1.
+func ValidateRef(ref string) error {
+	switch {
+	case ref == "":
+		return fmt.Errorf("ref must not be empty")
+	case strings.ContainsAny(ref, "/ \t\r\n"):
+		return fmt.Errorf("invalid ref %q: must not contain slashes or whitespace", ref)
+	}
+	return nil
+}
+
+// ValidateRepositoryPattern returns an error if pattern is neither a valid
+// repository name nor a policy wildcard pattern for repository names
+func ValidateRepositoryPattern(pattern string) error {
+	if strings.ContainsAny(pattern, "*?") {
+		if !repositoryWildcardPattern.MatchString(pattern) {
+			return fmt.Errorf("invalid repository pattern %q: must consist of lowercase letters, digits, hyphens and the * and ? wildcards", pattern)
+		}
+		return nil
+	}
+	return ValidateRepositoryName(pattern)
+}
+
+// ValidateBranchPattern returns an error if pattern is neither a valid branch
+// name nor a policy wildcard pattern for branch names
+func ValidateBranchPattern(pattern string) error {
+	if strings.ContainsAny(pattern, "*?") {
+		if !branchWildcardPattern.MatchString(pattern) {
+			return fmt.Errorf("invalid branch pattern %q: must consist of letters, digits, underscores, hyphens and the * and ? wildcards", pattern)
+		}
+		return nil
+	}
+	return ValidateBranchName(pattern)
+}
@@ -0,0 +1,122 @@
+package naming
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateRepositoryName(t *testing.T) {
+	tests := map[string]bool{
+		"my-repo":               true,
+		"abc":                   true,
+		"0repo":                 true,
+		"ab":                    false,
+		"My-Repo":               false,
+		"my_repo":               false,
+		"-repo":                 false,
+		strings.Repeat("a", 64): false,
+	}
+
+	for name, valid := range tests {
+		if err := ValidateRepositoryName(name); (err == nil) != valid {
+			t.Errorf("ValidateRepositoryName(%q) = %v, expected valid: %t", name, err, valid)
+		}
+	}
+}
+
+func TestValidateBranchName(t *testing.T) {
+	tests := map[string]bool{
+		"main":        true,
+		"feature_1-x": true,
+		"_private":    true,
+		"-main":       false,
+		"feature/x":   false,
+		"a b":         false,
+		"":            false,
+	}
+
+	for name, valid := range tests {
+		if err := ValidateBranchName(name); (err == nil) != valid {
+			t.Errorf("ValidateBranchName(%q) = %v, expected valid: %t", name, err, valid)
+		}
+	}
+}
+
+func TestValidateRef(t *testing.T) {
+	tests := map[string]bool{
+		"main":   true,
+		"main~1": true,
+		"v1.0.0": true,
+		"":       false,
+		"a/b":    false,
+		"main 1": false,
+	}
+
+	for ref, valid := range tests {
+		if err := ValidateRef(ref); (err == nil) != valid {
+			t.Errorf("ValidateRef(%q) = %v, expected valid: %t", ref, err, valid)
+		}
+	}
+}
+
+func TestValidatePatterns(t *testing.T) {
+	for _, pattern := range []string{"*", "prod-*", "my-repo", "repo-?"} {
+		if err := ValidateRepositoryPattern(pattern); err != nil {
+			t.Errorf("ValidateRepositoryPattern(%q) = %v", pattern, err)
+		}
+	}
+	for _, pattern := range []string{"Prod-*", "repo/*", "ab"} {
+		if err := ValidateRepositoryPattern(pattern); err == nil {
+			t.Errorf("expected ValidateRepositoryPattern(%q) to fail", pattern)
+		}
+	}
+
+	for _, pattern := range []string{"*", "feature-*", "main"} {
+		if err := ValidateBranchPattern(pattern); err != nil {
+			t.Errorf("ValidateBranchPattern(%q) = %v", pattern, err)
+		}
+	}
+	if err := ValidateBranchPattern("feature/*"); err == nil {
+		t.Error("expected ValidateBranchPattern to reject slashes")
+	}
+}
+
+func TestValidateTagName(t *testing.T) {
+	tests := map[string]bool{
+		"v1.0.0":       true,
+		"release/2024": true,
+		"":             false,
+		"@":            false,
+		"v1.":          false,
+		"v1.lock":      false,
+		"release/":     false,
+		"a..b":         false,
+		"a@{b":         false,
+		"v 1":          false,
+		"v1~1":         false,
+		"v1:latest":    false,
+	}
+
+	for name, valid := range tests {
+		if err := ValidateTagName(name); (err == nil) != valid {
+			t.Errorf("ValidateTagName(%q) = %v, expected valid: %t", name, err, valid)
+		}
+	}
+}
+
+func TestValidateAuthID(t *testing.T) {
+	tests := map[string]bool{
+		"admin":             true,
+		"jane.doe@acme.com": true,
+		"Data_Engineers":    true,
+		"":                  false,
+		"jane doe":          false,
+		"team/a":            false,
+	}
+
+	for id, valid := range tests {
+		if err := ValidateAuthID(id); (err == nil) != valid {
+			t.Errorf("ValidateAuthID(%q) = %v, expected valid: %t", id, err, valid)
+		}
+	}
+}
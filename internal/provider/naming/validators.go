@@ -0,0 +1,74 @@
+package naming
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = nameValidator{}
+
+// nameValidator validates a string attribute with one of the naming rules
+type nameValidator struct {
+	summary     string
+	description string
+	validate    func(string) error
+}
+
+func (v nameValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v nameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.description
+}
+
+func (v nameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, v.summary, err.Error())
+	}
+}
+
+// RepositoryName returns a validator which ensures that a string is a valid
+// repository name
+func RepositoryName() validator.String {
+	return nameValidator{
+		summary:     "Invalid Repository Name",
+		description: "value must be a valid LakeFS repository name",
+		validate:    ValidateRepositoryName,
+	}
+}
+
+// BranchName returns a validator which ensures that a string is a valid
+// branch name
+func BranchName() validator.String {
+	return nameValidator{
+		summary:     "Invalid Branch Name",
+		description: "value must be a valid LakeFS branch name",
+		validate:    ValidateBranchName,
+	}
+}
+
+// TagName returns a validator which ensures that a string is a valid tag name
+func TagName() validator.String {
+	return nameValidator{
+		summary:     "Invalid Tag Name",
+		description: "value must be a valid LakeFS tag name",
+		validate:    ValidateTagName,
+	}
+}
+
+// AuthID returns a validator which ensures that a string is a valid user,
+// group or policy ID
+func AuthID() validator.String {
+	return nameValidator{
+		summary:     "Invalid ID",
+		description: "value must be a valid LakeFS user, group or policy ID",
+		validate:    ValidateAuthID,
+	}
+}
@@ -0,0 +1,43 @@
+package naming
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNameValidatorReportsAttributePath(t *testing.T) {
+	attrPath := path.Root("name")
+	req := validator.StringRequest{
+		Path:        attrPath,
+		ConfigValue: types.StringValue("My_Repo"),
+	}
+	resp := &validator.StringResponse{}
+
+	RepositoryName().ValidateString(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got: %v", resp.Diagnostics)
+	}
+	diagWithPath, ok := resp.Diagnostics[0].(interface{ Path() path.Path })
+	if !ok || !diagWithPath.Path().Equal(attrPath) {
+		t.Errorf("expected the error to name %s, got: %v", attrPath, resp.Diagnostics[0])
+	}
+}
+
+func TestNameValidatorSkipsUnknownValues(t *testing.T) {
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown()} {
+		resp := &validator.StringResponse{}
+		BranchName().ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("name"),
+			ConfigValue: value,
+		}, resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected errors for %s: %v", value, resp.Diagnostics)
+		}
+	}
+}
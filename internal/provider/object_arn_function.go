@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ObjectARNFunction{}
+
+func NewObjectARNFunction() function.Function {
+	return &ObjectARNFunction{}
+}
+
+// ObjectARNFunction defines the function implementation.
+type ObjectARNFunction struct{}
+
+func (f *ObjectARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "object_arn"
+}
+
+func (f *ObjectARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the policy resource ARN of objects in a repository",
+		Description:         "Builds the arn:lakefs:fs:::repository/name/object/path ARN used in policy statements. The repository and path may use * and ? wildcards.",
+		MarkdownDescription: "Builds the `arn:lakefs:fs:::repository/name/object/path` ARN used in policy statements. The repository and path may use `*` and `?` wildcards.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "repository",
+				Description: "The name of the repository, or a wildcard pattern",
+			},
+			function.StringParameter{
+				Name:        "path",
+				Description: "The object path, or a wildcard pattern such as data/*",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ObjectARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var repository, path string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &repository, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := naming.ValidateRepositoryPattern(repository); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if path == "" {
+		resp.Error = function.NewArgumentFuncError(1, "path must not be empty, use * for all objects")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, repositoryARNPrefix+repository+"/object/"+path))
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseURIFunction{}
+
+// parsedURIAttrTypes are the attribute types of the object returned by parse_uri
+var parsedURIAttrTypes = map[string]attr.Type{
+	"repository": types.StringType,
+	"ref":        types.StringType,
+	"path":       types.StringType,
+}
+
+func NewParseURIFunction() function.Function {
+	return &ParseURIFunction{}
+}
+
+// ParseURIFunction defines the function implementation.
+type ParseURIFunction struct{}
+
+func (f *ParseURIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_uri"
+}
+
+func (f *ParseURIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a LakeFS URI",
+		Description:         "Parses a lakefs://repository/ref/path URI into an object with repository, ref and path attributes. The ref and path are null if the URI does not include them.",
+		MarkdownDescription: "Parses a `lakefs://repository/ref/path` URI into an object with `repository`, `ref` and `path` attributes. The `ref` and `path` are null if the URI does not include them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uri",
+				Description: "The LakeFS URI to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedURIAttrTypes,
+		},
+	}
+}
+
+func (f *ParseURIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri))
+	if resp.Error != nil {
+		return
+	}
+
+	repository, ref, path, err := parseURI(uri)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(parsedURIAttrTypes, map[string]attr.Value{
+		"repository": types.StringValue(repository),
+		"ref":        optionalStringValue(ref),
+		"path":       optionalStringValue(path),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
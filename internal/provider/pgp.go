@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+)
+
+// readPGPPublicKey parses a PGP public key given either ASCII armored or as
+// base64 encoded binary, the format exported by `gpg --export | base64`
+func readPGPPublicKey(key string) (*openpgp.Entity, error) {
+	key = strings.TrimSpace(key)
+
+	var keyBytes []byte
+	if strings.HasPrefix(key, "-----BEGIN") {
+		block, err := armor.Decode(strings.NewReader(key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode armored PGP key: %w", err)
+		}
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(block.Body); err != nil {
+			return nil, fmt.Errorf("failed to read armored PGP key: %w", err)
+		}
+		keyBytes = buf.Bytes()
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 PGP key: %w", err)
+		}
+		keyBytes = decoded
+	}
+
+	entities, err := openpgp.ReadKeyRing(bytes.NewReader(keyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PGP key: %w", err)
+	}
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("expected exactly one PGP key, got %d", len(entities))
+	}
+
+	return entities[0], nil
+}
+
+// encryptWithPGPKey encrypts plaintext for the given public key and returns
+// the base64 encoded binary message together with the key's fingerprint.
+// The message can be decrypted with `base64 -d | gpg --decrypt`.
+func encryptWithPGPKey(key, plaintext string) (string, string, error) {
+	entity, err := readPGPPublicKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	var buf bytes.Buffer
+	w, err := openpgp.Encrypt(&buf, []*openpgp.Entity{entity}, nil, nil, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+	if _, err := w.Write([]byte(plaintext)); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), hex.EncodeToString(entity.PrimaryKey.Fingerprint), nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+)
+
+func TestEncryptWithPGPKey(t *testing.T) {
+	entity, err := openpgp.NewEntity("lakefs", "test", "lakefs@example.com", nil)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	var binaryKey bytes.Buffer
+	if err := entity.Serialize(&binaryKey); err != nil {
+		t.Fatalf("unable to serialize key: %s", err)
+	}
+
+	var armoredKey bytes.Buffer
+	w, err := armor.Encode(&armoredKey, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("unable to armor key: %s", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("unable to serialize key: %s", err)
+	}
+	w.Close()
+
+	for name, key := range map[string]string{
+		"base64":  base64.StdEncoding.EncodeToString(binaryKey.Bytes()),
+		"armored": armoredKey.String(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			encrypted, fingerprint, err := encryptWithPGPKey(key, "secret")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if fingerprint != hex.EncodeToString(entity.PrimaryKey.Fingerprint) {
+				t.Errorf("unexpected fingerprint %s", fingerprint)
+			}
+
+			ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+			if err != nil {
+				t.Fatalf("encrypted secret is not base64: %s", err)
+			}
+			md, err := openpgp.ReadMessage(bytes.NewReader(ciphertext), openpgp.EntityList{entity}, nil, nil)
+			if err != nil {
+				t.Fatalf("unable to decrypt: %s", err)
+			}
+			plaintext, err := io.ReadAll(md.UnverifiedBody)
+			if err != nil {
+				t.Fatalf("unable to read decrypted message: %s", err)
+			}
+			if string(plaintext) != "secret" {
+				t.Errorf("unexpected plaintext %q", plaintext)
+			}
+		})
+	}
+
+	if _, _, err := encryptWithPGPKey("not a key", "secret"); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_policies"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PoliciesDataSource{}
+
+func NewPoliciesDataSource() datasource.DataSource {
+	return &PoliciesDataSource{}
+}
+
+// PoliciesDataSource defines the data source implementation.
+type PoliciesDataSource struct {
+	dataSourceBase
+}
+
+func (d *PoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *PoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_policies.PoliciesDataSourceSchema(ctx)
+}
+
+func (d *PoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_policies.PoliciesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkServerRequirement(ctx, d.client, "lakefs_policies", serverRequirement{RBAC: true})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	results, err := client.listPolicies(ctx, lakefsapi.ListPoliciesParams{Prefix: optional(data.Prefix.ValueString())})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list policies", err)
+		return
+	}
+
+	data.Id = types.StringValue("policies")
+	data.Policies = make([]datasource_policies.PolicyModel, 0, len(results))
+	for _, policy := range results {
+		data.Policies = append(data.Policies, datasource_policies.PolicyModel{
+			Id:           types.StringValue(policy.ID),
+			CreationDate: types.Int64Value(policy.CreationDate),
+			Statement:    jsontypes.NewNormalizedValue(string(policy.Statement)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,9 +2,8 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -20,7 +19,7 @@ func NewPolicyDataSource() datasource.DataSource {
 
 // PolicyDataSource defines the data source implementation.
 type PolicyDataSource struct {
-	client *LakeFSClient
+	dataSourceBase
 }
 
 func (d *PolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -31,23 +30,6 @@ func (d *PolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	resp.Schema = datasource_policy.PolicyDataSourceSchema(ctx)
 }
 
-func (d *PolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
-}
-
 func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data datasource_policy.PolicyModel
 
@@ -56,24 +38,23 @@ func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	client := NewAPIClient(d.client)
-
-	var result PolicyResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/policies/%s", data.Id.ValueString()), &result)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy: %s", err))
+	resp.Diagnostics.Append(checkServerRequirement(ctx, d.client, "lakefs_policy", serverRequirement{RBAC: true})...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
+	client := NewAPIClient(d.client)
 
-	statementBytes, err := json.Marshal(result.Statement)
+	result, err := client.GetPolicyWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Marshal Error", fmt.Sprintf("Unable to marshal policy statement: %s", err))
+		addClientError(&resp.Diagnostics, "read policy", err)
 		return
 	}
-	data.Statement = types.StringValue(string(statementBytes))
+
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+
+	data.Statement = jsontypes.NewNormalizedValue(string(result.JSON200.Statement))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
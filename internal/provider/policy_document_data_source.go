@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_policy_document"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PolicyDocumentDataSource{}
+
+func NewPolicyDocumentDataSource() datasource.DataSource {
+	return &PolicyDocumentDataSource{}
+}
+
+// PolicyDocumentDataSource defines the data source implementation.
+// It is computed locally and never calls the LakeFS API.
+type PolicyDocumentDataSource struct{}
+
+// PolicyStatement represents a single statement of a LakeFS policy
+type PolicyStatement struct {
+	Action    []string                       `json:"action"`
+	Condition map[string]map[string][]string `json:"condition,omitempty"`
+	Effect    string                         `json:"effect"`
+	Resource  string                         `json:"resource"`
+}
+
+func (d *PolicyDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_document"
+}
+
+func (d *PolicyDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_policy_document.PolicyDocumentDataSourceSchema(ctx)
+}
+
+func (d *PolicyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_policy_document.PolicyDocumentModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statements []PolicyStatement
+
+	if !data.SourcePolicyDocuments.IsNull() {
+		var sources []string
+		resp.Diagnostics.Append(data.SourcePolicyDocuments.ElementsAs(ctx, &sources, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i, source := range sources {
+			sourceStatements, err := parsePolicyStatements(source)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("source_policy_documents").AtListIndex(i),
+					"Invalid Policy Document",
+					fmt.Sprintf("Unable to parse source policy document: %s", err),
+				)
+				continue
+			}
+			statements = append(statements, sourceStatements...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, s := range data.Statement {
+		statement := PolicyStatement{
+			Effect:   "allow",
+			Resource: s.Resource.ValueString(),
+		}
+		if !s.Effect.IsNull() {
+			statement.Effect = s.Effect.ValueString()
+		}
+
+		resp.Diagnostics.Append(s.Action.ElementsAs(ctx, &statement.Action, false)...)
+
+		for _, c := range s.Condition {
+			var values []string
+			resp.Diagnostics.Append(c.Values.ElementsAs(ctx, &values, false)...)
+
+			if statement.Condition == nil {
+				statement.Condition = make(map[string]map[string][]string)
+			}
+			operator := c.Operator.ValueString()
+			if statement.Condition[operator] == nil {
+				statement.Condition[operator] = make(map[string][]string)
+			}
+			key := c.Key.ValueString()
+			statement.Condition[operator][key] = append(statement.Condition[operator][key], values...)
+		}
+
+		statements = append(statements, statement)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, err := canonicalPolicyStatements(statements)
+	if err != nil {
+		resp.Diagnostics.AddError("Marshal Error", fmt.Sprintf("Unable to marshal policy document: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(document)
+	data.Id = types.StringValue(fmt.Sprintf("%x", sha256.Sum256([]byte(document))))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parsePolicyStatements parses a policy document, accepting either a bare
+// statement list or a full policy object with a "statement" key
+func parsePolicyStatements(document string) ([]PolicyStatement, error) {
+	trimmed := strings.TrimSpace(document)
+
+	var statements []PolicyStatement
+	if strings.HasPrefix(trimmed, "{") {
+		var policy struct {
+			Statement []PolicyStatement `json:"statement"`
+		}
+		if err := json.Unmarshal([]byte(trimmed), &policy); err != nil {
+			return nil, err
+		}
+		statements = policy.Statement
+	} else if err := json.Unmarshal([]byte(trimmed), &statements); err != nil {
+		return nil, err
+	}
+
+	return statements, nil
+}
+
+// canonicalPolicyStatements normalizes and deduplicates statements and returns
+// them as compact JSON. Actions and condition values are sorted and
+// deduplicated, effects are lower-cased, and statements that are identical
+// after normalization are emitted only once, keeping the first occurrence.
+func canonicalPolicyStatements(statements []PolicyStatement) (string, error) {
+	result := make([]PolicyStatement, 0, len(statements))
+	seen := make(map[string]bool)
+
+	for _, s := range statements {
+		s.Effect = strings.ToLower(s.Effect)
+		s.Action = sortedUnique(s.Action)
+		if len(s.Condition) > 0 {
+			condition := make(map[string]map[string][]string, len(s.Condition))
+			for operator, keys := range s.Condition {
+				condition[operator] = make(map[string][]string, len(keys))
+				for key, values := range keys {
+					condition[operator][key] = sortedUnique(values)
+				}
+			}
+			s.Condition = condition
+		} else {
+			s.Condition = nil
+		}
+
+		// encoding/json sorts map keys, so the encoding is a stable identity
+		encoded, err := json.Marshal(s)
+		if err != nil {
+			return "", err
+		}
+		if seen[string(encoded)] {
+			continue
+		}
+		seen[string(encoded)] = true
+		result = append(result, s)
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// sortedUnique returns a sorted copy of values with duplicates removed
+func sortedUnique(values []string) []string {
+	result := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	sort.Strings(result)
+	return result
+}
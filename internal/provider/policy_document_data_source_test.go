@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCanonicalPolicyStatements(t *testing.T) {
+	source, err := parsePolicyStatements(`{"id":"existing","statement":[{"resource":"arn:lakefs:fs:::repository/a","effect":"Allow","action":["fs:ReadObject","fs:ListObjects","fs:ReadObject"]}]}`)
+	if err != nil {
+		t.Fatalf("unexpected error parsing source document: %s", err)
+	}
+
+	statements := append(source,
+		PolicyStatement{Effect: "allow", Action: []string{"fs:ListObjects", "fs:ReadObject"}, Resource: "arn:lakefs:fs:::repository/a"},
+		PolicyStatement{Effect: "deny", Action: []string{"fs:DeleteObject"}, Resource: "arn:lakefs:fs:::repository/a/object/*",
+			Condition: map[string]map[string][]string{"IpAddress": {"SourceIp": {"10.0.0.0/8", "10.0.0.0/8"}}}},
+	)
+
+	got, err := canonicalPolicyStatements(statements)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `[{"action":["fs:ListObjects","fs:ReadObject"],"effect":"allow","resource":"arn:lakefs:fs:::repository/a"},` +
+		`{"action":["fs:DeleteObject"],"condition":{"IpAddress":{"SourceIp":["10.0.0.0/8"]}},"effect":"deny","resource":"arn:lakefs:fs:::repository/a/object/*"}]`
+	if got != want {
+		t.Errorf("unexpected document\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestParsePolicyStatementsList(t *testing.T) {
+	statements, err := parsePolicyStatements(`[{"effect":"allow","action":["fs:*"],"resource":"*"}]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(statements) != 1 || statements[0].Resource != "*" {
+		t.Errorf("unexpected statements: %+v", statements)
+	}
+
+	if _, err := parsePolicyStatements(`not json`); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestAccPolicyDocumentDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_policy_document.test", "json",
+						`[{"action":["fs:ReadObject"],"effect":"allow","resource":"arn:lakefs:fs:::repository/*"},`+
+							`{"action":["fs:DeleteObject","fs:WriteObject"],"effect":"deny","resource":"arn:lakefs:fs:::repository/prod/*"}]`),
+					resource.TestCheckResourceAttrSet("data.lakefs_policy_document.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyDocumentDataSourceConfig = `
+data "lakefs_policy_document" "test" {
+  source_policy_documents = [
+    jsonencode([{ effect = "allow", action = ["fs:ReadObject"], resource = "arn:lakefs:fs:::repository/*" }])
+  ]
+
+  statement {
+    action   = ["fs:ReadObject"]
+    resource = "arn:lakefs:fs:::repository/*"
+  }
+
+  statement {
+    effect   = "deny"
+    action   = ["fs:WriteObject", "fs:DeleteObject"]
+    resource = "arn:lakefs:fs:::repository/prod/*"
+  }
+}
+`
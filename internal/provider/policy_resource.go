@@ -3,20 +3,21 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_policy"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PolicyResource{}
 var _ resource.ResourceWithImportState = &PolicyResource{}
+var _ resource.ResourceWithModifyPlan = &PolicyResource{}
 
 func NewPolicyResource() resource.Resource {
 	return &PolicyResource{}
@@ -24,32 +25,7 @@ func NewPolicyResource() resource.Resource {
 
 // PolicyResource defines the resource implementation.
 type PolicyResource struct {
-	client *LakeFSClient
-}
-
-// PolicyCreateRequest represents the request to create a policy
-type PolicyCreateRequest struct {
-	ID        string          `json:"id"`
-	Statement json.RawMessage `json:"statement"`
-}
-
-// PolicyResponse represents the API response for a policy
-type PolicyResponse struct {
-	ID           string          `json:"id"`
-	CreationDate int64           `json:"creation_date"`
-	Statement    json.RawMessage `json:"statement"`
-}
-
-// jsonEqual compares two JSON strings for semantic equality (ignoring key order)
-func jsonEqual(a, b string) bool {
-	var objA, objB interface{}
-	if err := json.Unmarshal([]byte(a), &objA); err != nil {
-		return false
-	}
-	if err := json.Unmarshal([]byte(b), &objB); err != nil {
-		return false
-	}
-	return reflect.DeepEqual(objA, objB)
+	resourceBase
 }
 
 func (r *PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,21 +36,14 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = resource_policy.PolicyResourceSchema(ctx)
 }
 
-func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+// ModifyPlan reports servers without RBAC, which cannot manage policies
+func (r *PolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	r.client = client
+	resp.Diagnostics.Append(checkServerRequirement(ctx, r.client, "lakefs_policy", serverRequirement{RBAC: true})...)
 }
 
 func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -85,33 +54,33 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	createReq := PolicyCreateRequest{
+	createReq := lakefsapi.Policy{
 		ID:        data.Id.ValueString(),
 		Statement: json.RawMessage(data.Statement.ValueString()),
 	}
 
-	var result PolicyResponse
-	err := client.Post(ctx, "/auth/policies", createReq, &result)
+	result, err := client.CreatePolicyWithResponse(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create policy: %s", err))
+		addClientError(&resp.Diagnostics, "create policy", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-
-	// Preserve the original statement format if semantically equal to avoid diffs from key ordering
-	resultStatement := string(result.Statement)
-	originalStatement := data.Statement.ValueString()
-	if jsonEqual(originalStatement, resultStatement) {
-		// Keep the original format
-		data.Statement = types.StringValue(originalStatement)
-	} else {
-		// Use the API's response (this shouldn't normally happen)
-		data.Statement = types.StringValue(resultStatement)
-	}
+	data.Id = types.StringValue(result.JSON201.ID)
+	data.CreationDate = types.Int64Value(result.JSON201.CreationDate)
+
+	// The framework keeps the configured formatting when the API's statement is semantically equal
+	data.Statement = jsontypes.NewNormalizedValue(string(result.JSON201.Statement))
 
 	tflog.Trace(ctx, "created a policy resource")
 
@@ -126,32 +95,28 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	var result PolicyResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/policies/%s", data.Id.ValueString()), &result)
+	result, err := client.GetPolicyWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy: %s", err))
+		readFailed(ctx, resp, "read policy", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-
-	// Preserve the original statement format if semantically equal to avoid diffs from key ordering
-	resultStatement := string(result.Statement)
-	originalStatement := data.Statement.ValueString()
-	if jsonEqual(originalStatement, resultStatement) {
-		// Keep the original format
-		data.Statement = types.StringValue(originalStatement)
-	} else {
-		// Use the API's response
-		data.Statement = types.StringValue(resultStatement)
-	}
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+
+	// The framework keeps the configured formatting when the API's statement is semantically equal
+	data.Statement = jsontypes.NewNormalizedValue(string(result.JSON200.Statement))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -164,29 +129,33 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	updateReq := PolicyCreateRequest{
+	updateReq := lakefsapi.Policy{
 		ID:        data.Id.ValueString(),
 		Statement: json.RawMessage(data.Statement.ValueString()),
 	}
 
-	var result PolicyResponse
-	err := client.Put(ctx, fmt.Sprintf("/auth/policies/%s", data.Id.ValueString()), updateReq, &result)
+	result, err := client.UpdatePolicyWithResponse(ctx, data.Id.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy: %s", err))
+		addClientError(&resp.Diagnostics, "update policy", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
 
-	statementBytes, err := json.Marshal(result.Statement)
-	if err != nil {
-		resp.Diagnostics.AddError("Marshal Error", fmt.Sprintf("Unable to marshal policy statement: %s", err))
-		return
-	}
-	data.Statement = types.StringValue(string(statementBytes))
+	// The framework keeps the configured formatting when the API's statement is semantically equal
+	data.Statement = jsontypes.NewNormalizedValue(string(result.JSON200.Statement))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -199,11 +168,20 @@ func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	err := client.Delete(ctx, fmt.Sprintf("/auth/policies/%s", data.Id.ValueString()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete policy: %s", err))
+	_, err := client.DeletePolicyWithResponse(ctx, data.Id.ValueString())
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, "delete policy", err)
 		return
 	}
 }
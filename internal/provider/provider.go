@@ -8,6 +8,8 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,6 +20,8 @@ import (
 
 // Ensure LakeFSProvider satisfies various provider interfaces.
 var _ provider.Provider = &LakeFSProvider{}
+var _ provider.ProviderWithEphemeralResources = &LakeFSProvider{}
+var _ provider.ProviderWithFunctions = &LakeFSProvider{}
 
 // LakeFSProvider defines the provider implementation.
 type LakeFSProvider struct {
@@ -41,6 +45,9 @@ type LakeFSClient struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	SkipSSLVerify   bool
+
+	// server caches the capabilities of the LakeFS server
+	server serverInfo
 }
 
 func (p *LakeFSProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -172,9 +179,14 @@ func (p *LakeFSProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		"endpoint": endpoint,
 	})
 
+	// Fetch the server capabilities once, so resources can report missing
+	// features at plan time
+	client.loadServerInfo(ctx)
+
 	// Make the client available to resources and data sources
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *LakeFSProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -183,6 +195,7 @@ func (p *LakeFSProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewBranchResource,
 		NewTagResource,
 		NewBranchProtectionResource,
+		NewImportResource,
 		NewUserResource,
 		NewGroupResource,
 		NewPolicyResource,
@@ -190,18 +203,47 @@ func (p *LakeFSProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewUserPolicyAttachmentResource,
 		NewGroupPolicyAttachmentResource,
 		NewUserCredentialsResource,
+		NewSetupResource,
 	}
 }
 
 func (p *LakeFSProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRepositoryDataSource,
+		NewRepositoriesDataSource,
 		NewBranchDataSource,
+		NewBranchesDataSource,
+		NewRefDataSource,
+		NewTagsDataSource,
 		NewCommitDataSource,
+		NewCommitLogDataSource,
+		NewDiffDataSource,
 		NewCurrentUserDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
+		NewUserCredentialsListDataSource,
 		NewGroupDataSource,
+		NewGroupsDataSource,
 		NewPolicyDataSource,
+		NewPoliciesDataSource,
+		NewPolicyDocumentDataSource,
+		NewStoragesDataSource,
+	}
+}
+
+func (p *LakeFSProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCredentialsEphemeralResource,
+	}
+}
+
+func (p *LakeFSProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewURIFunction,
+		NewParseURIFunction,
+		NewRepositoryARNFunction,
+		NewObjectARNFunction,
+		NewBranchARNFunction,
 	}
 }
 
@@ -6,12 +6,16 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -45,33 +49,50 @@ func TestAccRepositoryResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccRepositoryResourceConfig(repoName),
+				Config: testAccRepositoryResourceConfig(repoName, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lakefs_repository.test", "name", repoName),
 					resource.TestCheckResourceAttr("lakefs_repository.test", "default_branch", "main"),
 					resource.TestCheckResourceAttrSet("lakefs_repository.test", "creation_date"),
 				),
 			},
+			// Setting force_destroy updates in place and keeps the computed attributes
+			{
+				Config: testAccRepositoryResourceConfig(repoName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lakefs_repository.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("lakefs_repository.test", tfjsonpath.New("id"), knownvalue.StringExact(repoName)),
+						plancheck.ExpectKnownValue("lakefs_repository.test", tfjsonpath.New("creation_date"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_repository.test", "force_destroy", "true"),
+					resource.TestCheckResourceAttr("lakefs_repository.test", "id", repoName),
+					resource.TestCheckResourceAttrSet("lakefs_repository.test", "creation_date"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "lakefs_repository.test",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// sample_data is not returned by the API
-				ImportStateVerifyIgnore: []string{"sample_data"},
+				// sample_data and force_destroy are not returned by the API
+				ImportStateVerifyIgnore: []string{"sample_data", "force_destroy"},
 			},
 		},
 	})
 }
 
-func testAccRepositoryResourceConfig(name string) string {
+func testAccRepositoryResourceConfig(name string, forceDestroy bool) string {
 	return fmt.Sprintf(`
 resource "lakefs_repository" "test" {
   name              = %[1]q
   storage_namespace = "s3://lakefs-data/%[1]s"
   default_branch    = "main"
+  force_destroy     = %[2]t
 }
-`, name)
+`, name, forceDestroy)
 }
 
 func TestAccBranchResource(t *testing.T) {
@@ -141,17 +162,39 @@ func TestAccTagResource(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTagResourceConfig(repoName, tagName),
+				Config: testAccTagResourceConfig(repoName, tagName, `"main"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lakefs_tag.test", "id", tagName),
 					resource.TestCheckResourceAttrSet("lakefs_tag.test", "commit_id"),
+					resource.TestCheckResourceAttrSet("lakefs_tag.test", "commit_message"),
+					resource.TestCheckResourceAttrSet("lakefs_tag.test", "committer"),
+					resource.TestCheckResourceAttrSet("lakefs_tag.test", "commit_creation_date"),
+				),
+			},
+			// A ref naming the same commit updates in place instead of replacing
+			{
+				Config: testAccTagResourceConfig(repoName, tagName, "data.lakefs_branch.main.commit_id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lakefs_tag.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("lakefs_tag.test", "commit_id", "data.lakefs_branch.main", "commit_id"),
 				),
 			},
+			{
+				ResourceName:            "lakefs_tag.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s", repoName, tagName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
 		},
 	})
 }
 
-func testAccTagResourceConfig(repoName, tagName string) string {
+func testAccTagResourceConfig(repoName, tagName, ref string) string {
 	return fmt.Sprintf(`
 resource "lakefs_repository" "test" {
   name              = %[1]q
@@ -159,12 +202,17 @@ resource "lakefs_repository" "test" {
   default_branch    = "main"
 }
 
+data "lakefs_branch" "main" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+}
+
 resource "lakefs_tag" "test" {
   repository = lakefs_repository.test.id
   id         = %[2]q
-  ref        = "main"
+  ref        = %[3]s
 }
-`, repoName, tagName)
+`, repoName, tagName, ref)
 }
 
 // =====================
@@ -319,3 +367,292 @@ data "lakefs_commit" "test" {
 }
 `, repoName)
 }
+
+func TestAccCommitLogDataSource(t *testing.T) {
+	repoName := fmt.Sprintf("dslog%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommitLogDataSourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_commit_log.test", "commits.#", "1"),
+					resource.TestCheckResourceAttrPair("data.lakefs_commit_log.test", "commits.0.id", "data.lakefs_branch.main", "commit_id"),
+					resource.TestCheckResourceAttrSet("data.lakefs_commit_log.test", "commits.0.message"),
+					resource.TestCheckResourceAttr("data.lakefs_commit_log.filtered", "commits.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCommitLogDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+data "lakefs_branch" "main" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+}
+
+data "lakefs_commit_log" "test" {
+  repository = lakefs_repository.test.id
+  ref        = data.lakefs_branch.main.branch
+  amount     = 1
+}
+
+data "lakefs_commit_log" "filtered" {
+  repository = lakefs_repository.test.id
+  ref        = data.lakefs_branch.main.branch
+  prefixes   = ["tables/orders/"]
+}
+`, repoName)
+}
+
+func TestAccRefDataSource(t *testing.T) {
+	repoName := fmt.Sprintf("dsref%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRefDataSourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_ref.branch", "kind", "branch"),
+					resource.TestCheckResourceAttrPair("data.lakefs_ref.branch", "commit_id", "data.lakefs_branch.main", "commit_id"),
+					resource.TestCheckResourceAttr("data.lakefs_ref.tag", "kind", "tag"),
+					resource.TestCheckResourceAttrPair("data.lakefs_ref.tag", "commit_id", "data.lakefs_branch.main", "commit_id"),
+					resource.TestCheckResourceAttr("data.lakefs_ref.commit", "kind", "commit"),
+					resource.TestCheckResourceAttrPair("data.lakefs_ref.commit", "commit_id", "data.lakefs_branch.main", "commit_id"),
+					resource.TestCheckResourceAttr("data.lakefs_ref.expression", "kind", "commit"),
+					resource.TestCheckResourceAttrPair("data.lakefs_ref.expression", "commit_id", "data.lakefs_branch.main", "commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRefDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_tag" "test" {
+  repository = lakefs_repository.test.id
+  id         = "v1"
+  ref        = "main"
+}
+
+data "lakefs_branch" "main" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+}
+
+data "lakefs_ref" "branch" {
+  repository = lakefs_repository.test.id
+  ref        = "main"
+}
+
+data "lakefs_ref" "tag" {
+  repository = lakefs_tag.test.repository
+  ref        = lakefs_tag.test.id
+}
+
+data "lakefs_ref" "commit" {
+  repository = lakefs_repository.test.id
+  ref        = data.lakefs_branch.main.commit_id
+}
+
+data "lakefs_ref" "expression" {
+  repository = lakefs_repository.test.id
+  ref        = "main@"
+}
+`, repoName)
+}
+
+func TestAccDiffDataSource(t *testing.T) {
+	repoName := fmt.Sprintf("dsdiff%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiffDataSourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_diff.refs", "entries.#", "0"),
+					resource.TestCheckResourceAttr("data.lakefs_diff.refs", "summary.total", "0"),
+					resource.TestCheckResourceAttr("data.lakefs_diff.uncommitted", "entries.#", "0"),
+					resource.TestCheckResourceAttr("data.lakefs_diff.uncommitted", "summary.removed", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDiffDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_branch" "test" {
+  repository = lakefs_repository.test.id
+  name       = "develop"
+  source     = "main"
+}
+
+data "lakefs_diff" "refs" {
+  repository = lakefs_repository.test.id
+  left_ref   = "main"
+  right_ref  = lakefs_branch.test.name
+  diff_type  = "two_dot"
+  prefix     = "tables/"
+  types      = ["removed"]
+}
+
+data "lakefs_diff" "uncommitted" {
+  repository = lakefs_repository.test.id
+  left_ref   = lakefs_branch.test.name
+  delimiter  = "/"
+}
+`, repoName)
+}
+
+// =====================
+// List Data Source Tests
+// =====================
+
+func TestAccListDataSources(t *testing.T) {
+	repoName := fmt.Sprintf("dslist%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListDataSourcesConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_repositories.test", "repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_repositories.test", "repositories.0.id", repoName),
+					resource.TestCheckResourceAttr("data.lakefs_repositories.test", "repositories.0.default_branch", "main"),
+					resource.TestCheckResourceAttr("data.lakefs_branches.test", "branches.#", "2"),
+					resource.TestCheckResourceAttr("data.lakefs_tags.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_tags.test", "tags.0.id", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccListDataSourcesConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_branch" "test" {
+  repository = lakefs_repository.test.id
+  name       = "develop"
+  source     = "main"
+}
+
+resource "lakefs_tag" "test" {
+  repository = lakefs_repository.test.id
+  id         = "v1"
+  ref        = "main"
+}
+
+data "lakefs_repositories" "test" {
+  prefix = lakefs_repository.test.id
+}
+
+data "lakefs_branches" "test" {
+  repository = lakefs_branch.test.repository
+}
+
+data "lakefs_tags" "test" {
+  repository = lakefs_tag.test.repository
+}
+`, repoName)
+}
+
+func TestAccStoragesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "lakefs_storages" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lakefs_storages.test", "storages.0.blockstore_type"),
+					resource.TestCheckResourceAttrSet("data.lakefs_storages.test", "storages.0.namespace_example"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSetupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The test installation is already set up
+				Config: `
+resource "lakefs_setup" "test" {
+  username = "admin"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_setup.test", "id", "admin"),
+					resource.TestCheckResourceAttr("lakefs_setup.test", "initialized", "false"),
+					resource.TestCheckNoResourceAttr("lakefs_setup.test", "secret_access_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNamingValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "lakefs_repository" "test" {
+  name              = "My_Repo"
+  storage_namespace = "s3://lakefs-data/my-repo"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Repository Name`),
+			},
+			{
+				Config: `
+resource "lakefs_branch" "test" {
+  repository = "my-repo"
+  name       = "feature/x"
+  source     = "main"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Branch Name`),
+			},
+		},
+	})
+}
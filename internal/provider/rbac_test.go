@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccUserResource(t *testing.T) {
@@ -39,6 +50,47 @@ resource "lakefs_user" "test" {
 `, userName)
 }
 
+func TestAccUserResourceWithEmail(t *testing.T) {
+	userName := fmt.Sprintf("emailuser%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceWithEmailConfig(userName, "Test User"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_user.test", "email", userName+"@example.com"),
+					resource.TestCheckResourceAttr("lakefs_user.test", "friendly_name", "Test User"),
+					resource.TestCheckResourceAttr("lakefs_user.test", "invite_user", "false"),
+				),
+			},
+			// Changing the friendly name replaces the user
+			{
+				Config: testAccUserResourceWithEmailConfig(userName, "Renamed User"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_user.test", "friendly_name", "Renamed User"),
+				),
+			},
+			{
+				ResourceName:      "lakefs_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserResourceWithEmailConfig(userName, friendlyName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id            = %[1]q
+  email         = "%[1]s@example.com"
+  friendly_name = %[2]q
+}
+`, userName, friendlyName)
+}
+
 func TestAccGroupResource(t *testing.T) {
 	groupName := fmt.Sprintf("testgroup%d", time.Now().UnixNano())
 
@@ -73,6 +125,8 @@ resource "lakefs_group" "test" {
 func TestAccPolicyResource(t *testing.T) {
 	policyName := fmt.Sprintf("testpolicy%d", time.Now().UnixNano())
 	statement := `[{"effect":"allow","action":["fs:ReadObject"],"resource":"arn:lakefs:fs:::repository/*"}]`
+	// Differs in whitespace and key order from what the API returns, which must not cause a diff after update
+	updatedStatement := `[ { "resource": "arn:lakefs:fs:::repository/*", "action": [ "fs:ReadObject", "fs:ListObjects" ], "effect": "allow" } ]`
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -91,6 +145,12 @@ func TestAccPolicyResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"statement"}, // JSON key order may differ
 			},
+			{
+				Config: testAccPolicyResourceConfig(policyName, updatedStatement),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_policy.test", "statement", updatedStatement),
+				),
+			},
 		},
 	})
 }
@@ -193,10 +253,128 @@ func TestAccUserCredentialsResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("lakefs_user_credentials.test", "secret_access_key"),
 				),
 			},
+			{
+				ResourceName:      "lakefs_user_credentials.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccUserCredentialsImportStateIdFunc("lakefs_user_credentials.test"),
+				ImportStateVerify: true,
+				// The secret is only returned on creation
+				ImportStateVerifyIgnore: []string{"secret_access_key"},
+			},
+		},
+	})
+}
+
+func testAccUserCredentialsImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["user_id"], rs.Primary.Attributes["access_key_id"]), nil
+	}
+}
+
+func TestAccUserCredentialsResourcePGPKey(t *testing.T) {
+	userName := fmt.Sprintf("pgpuser%d", time.Now().UnixNano())
+
+	entity, err := openpgp.NewEntity("lakefs", "acceptance test", "lakefs@example.com", nil)
+	if err != nil {
+		t.Fatalf("unable to generate PGP key: %s", err)
+	}
+	var publicKey bytes.Buffer
+	if err := entity.Serialize(&publicKey); err != nil {
+		t.Fatalf("unable to serialize PGP key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserCredentialsResourcePGPKeyConfig(userName, base64.StdEncoding.EncodeToString(publicKey.Bytes())),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lakefs_user_credentials.test", "access_key_id"),
+					resource.TestCheckResourceAttrSet("lakefs_user_credentials.test", "encrypted_secret"),
+					resource.TestCheckResourceAttr("lakefs_user_credentials.test", "key_fingerprint", hex.EncodeToString(entity.PrimaryKey.Fingerprint)),
+					resource.TestCheckNoResourceAttr("lakefs_user_credentials.test", "secret_access_key"),
+				),
+			},
 		},
 	})
 }
 
+func testAccUserCredentialsResourcePGPKeyConfig(userName, pgpKey string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id = %[1]q
+}
+
+resource "lakefs_user_credentials" "test" {
+  user_id = lakefs_user.test.id
+  pgp_key = %[2]q
+}
+`, userName, pgpKey)
+}
+
+func TestAccUserCredentialsResourceRotation(t *testing.T) {
+	userName := fmt.Sprintf("rotuser%d", time.Now().UnixNano())
+	var firstAccessKeyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserCredentialsResourceRotationConfig(userName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("lakefs_user_credentials.test", "previous_access_key_id"),
+					func(s *terraform.State) error {
+						firstAccessKeyID = s.RootModule().Resources["lakefs_user_credentials.test"].Primary.Attributes["access_key_id"]
+						return nil
+					},
+				),
+			},
+			// Changing a keeper mints a new key pair and keeps the old one during the overlap
+			{
+				Config: testAccUserCredentialsResourceRotationConfig(userName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lakefs_user_credentials.test", "previous_secret_access_key"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources["lakefs_user_credentials.test"].Primary.Attributes
+						if attrs["previous_access_key_id"] != firstAccessKeyID {
+							return fmt.Errorf("expected previous_access_key_id %s, got %s", firstAccessKeyID, attrs["previous_access_key_id"])
+						}
+						if attrs["access_key_id"] == firstAccessKeyID {
+							return fmt.Errorf("expected a new access_key_id after rotation")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccUserCredentialsResourceRotationConfig(userName, version string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id = %[1]q
+}
+
+resource "lakefs_user_credentials" "test" {
+  user_id = lakefs_user.test.id
+
+  rotation = {
+    keepers = {
+      version = %[2]q
+    }
+    overlap = "1h"
+  }
+}
+`, userName, version)
+}
+
 func testAccUserCredentialsResourceConfig(userName string) string {
 	return fmt.Sprintf(`
 resource "lakefs_user" "test" {
@@ -208,3 +386,133 @@ resource "lakefs_user_credentials" "test" {
 }
 `, userName)
 }
+
+func TestAccCredentialsEphemeralResource(t *testing.T) {
+	userName := fmt.Sprintf("ephuser%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"lakefs": testAccProtoV6ProviderFactories["lakefs"],
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialsEphemeralResourceConfig(userName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("user_id"), knownvalue.StringExact(userName)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secret_access_key"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccCredentialsEphemeralResourceConfig(userName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id = %[1]q
+}
+
+ephemeral "lakefs_credentials" "test" {
+  user_id = lakefs_user.test.id
+}
+
+provider "echo" {
+  data = ephemeral.lakefs_credentials.test
+}
+
+resource "echo" "test" {}
+`, userName)
+}
+
+func TestAccAuthListDataSources(t *testing.T) {
+	userName := fmt.Sprintf("listuser%d", time.Now().UnixNano())
+	groupName := fmt.Sprintf("listgroup%d", time.Now().UnixNano())
+	policyName := fmt.Sprintf("listpolicy%d", time.Now().UnixNano())
+	statement := `[{"effect":"allow","action":["fs:ReadObject"],"resource":"arn:lakefs:fs:::repository/*"}]`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthListDataSourcesConfig(userName, groupName, policyName, statement),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_users.test", "users.0.id", userName),
+					resource.TestCheckResourceAttr("data.lakefs_users.test", "users.0.groups.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_users.test", "users.0.groups.0", groupName),
+					resource.TestCheckResourceAttr("data.lakefs_users.test", "users.0.policies.#", "0"),
+					resource.TestCheckTypeSetElemAttr("data.lakefs_users.test", "users.0.effective_policies.*", policyName),
+					resource.TestCheckResourceAttr("data.lakefs_groups.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_groups.test", "groups.0.members.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_groups.test", "groups.0.members.0", userName),
+					resource.TestCheckResourceAttr("data.lakefs_groups.test", "groups.0.policies.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_groups.test", "groups.0.policies.0", policyName),
+					resource.TestCheckResourceAttr("data.lakefs_policies.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_policies.test", "policies.0.id", policyName),
+					resource.TestCheckResourceAttr("data.lakefs_user_credentials_list.test", "credentials.#", "1"),
+					resource.TestCheckResourceAttrPair("data.lakefs_user_credentials_list.test", "credentials.0.access_key_id", "lakefs_user_credentials.test", "access_key_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuthListDataSourcesConfig(userName, groupName, policyName, statement string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id = %[1]q
+}
+
+resource "lakefs_group" "test" {
+  id = %[2]q
+}
+
+resource "lakefs_policy" "test" {
+  id        = %[3]q
+  statement = %[4]q
+}
+
+resource "lakefs_group_membership" "test" {
+  group_id = lakefs_group.test.id
+  user_id  = lakefs_user.test.id
+}
+
+resource "lakefs_group_policy_attachment" "test" {
+  group_id  = lakefs_group.test.id
+  policy_id = lakefs_policy.test.id
+}
+
+resource "lakefs_user_credentials" "test" {
+  user_id = lakefs_user.test.id
+}
+
+data "lakefs_users" "test" {
+  prefix = %[1]q
+
+  depends_on = [lakefs_group_membership.test, lakefs_group_policy_attachment.test]
+}
+
+data "lakefs_groups" "test" {
+  prefix = %[2]q
+
+  depends_on = [lakefs_group_membership.test, lakefs_group_policy_attachment.test]
+}
+
+data "lakefs_policies" "test" {
+  prefix = %[3]q
+
+  depends_on = [lakefs_policy.test]
+}
+
+data "lakefs_user_credentials_list" "test" {
+  user_id = lakefs_user_credentials.test.user_id
+}
+`, userName, groupName, policyName, statement)
+}
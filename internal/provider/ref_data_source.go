@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_ref"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RefDataSource{}
+
+func NewRefDataSource() datasource.DataSource {
+	return &RefDataSource{}
+}
+
+// RefDataSource defines the data source implementation.
+type RefDataSource struct {
+	dataSourceBase
+}
+
+// Kinds of refs reported by the lakefs_ref data source
+const (
+	refKindBranch = "branch"
+	refKindTag    = "tag"
+	refKindCommit = "commit"
+)
+
+func (d *RefDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ref"
+}
+
+func (d *RefDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_ref.RefDataSourceSchema(ctx)
+}
+
+func (d *RefDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_ref.RefModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	repository := data.Repository.ValueString()
+	ref := data.Ref.ValueString()
+
+	commit, err := resolveRefCommit(ctx, client, repository, ref)
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("resolve ref %q", ref), err)
+		return
+	}
+
+	kind, err := resolveRefKind(ctx, client, repository, ref)
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("resolve ref %q", ref), err)
+		return
+	}
+
+	tflog.Debug(ctx, "Resolved ref", map[string]any{
+		"repository": repository,
+		"ref":        ref,
+		"kind":       kind,
+		"commit_id":  commit.ID,
+	})
+
+	// Map response to state
+	data.Id = types.StringValue(commit.ID)
+	data.Kind = types.StringValue(kind)
+	data.CommitId = types.StringValue(commit.ID)
+	data.Committer = types.StringValue(commit.Committer)
+	data.Message = types.StringValue(commit.Message)
+	data.CreationDate = types.Int64Value(commit.CreationDate)
+	data.MetaRangeId = types.StringValue(commit.MetaRangeID)
+	data.Generation = types.Int64Value(commit.Generation)
+	data.Version = types.Int64Value(int64(commit.Version))
+
+	parents, diags := types.ListValueFrom(ctx, types.StringType, commit.Parents)
+	resp.Diagnostics.Append(diags...)
+	data.Parents = parents
+
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, commit.Metadata)
+	resp.Diagnostics.Append(diags...)
+	data.Metadata = metadata
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveRefCommit returns the commit that a ref expression resolves to
+func resolveRefCommit(ctx context.Context, client *APIClient, repository, ref string) (lakefsapi.Commit, error) {
+	// The first entry of the log is the commit the ref resolves to, whatever
+	// kind of ref expression it is
+	log, err := client.LogCommitsWithResponse(ctx, repository, ref, &lakefsapi.LogCommitsParams{Amount: optional(1), Limit: optional(true)})
+	if err != nil {
+		return lakefsapi.Commit{}, err
+	}
+	if len(log.JSON200.Results) == 0 {
+		return lakefsapi.Commit{}, fmt.Errorf("ref %q does not resolve to a commit", ref)
+	}
+
+	return log.JSON200.Results[0], nil
+}
+
+// resolveRefKind reports whether ref names a branch or a tag, checked in the
+// order LakeFS resolves them. Anything else is reported as a commit.
+func resolveRefKind(ctx context.Context, client *APIClient, repository, ref string) (string, error) {
+	if isRefExpression(ref) {
+		return refKindCommit, nil
+	}
+
+	_, err := client.GetBranchWithResponse(ctx, repository, ref)
+	if err == nil {
+		return refKindBranch, nil
+	}
+	if !IsNotFound(err) {
+		return "", err
+	}
+
+	_, err = client.GetTagWithResponse(ctx, repository, ref)
+	if err == nil {
+		return refKindTag, nil
+	}
+	if !IsNotFound(err) {
+		return "", err
+	}
+
+	return refKindCommit, nil
+}
+
+// isRefExpression reports whether ref uses a modifier (main~3, main^2, main@,
+// main$) and therefore cannot name a branch or tag directly
+func isRefExpression(ref string) bool {
+	return strings.ContainsAny(ref, "~^@$")
+}
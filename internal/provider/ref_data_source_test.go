@@ -0,0 +1,21 @@
+package provider
+
+import "testing"
+
+func TestIsRefExpression(t *testing.T) {
+	tests := map[string]bool{
+		"main":   false,
+		"v1.0.0": false,
+		"a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2": false,
+		"main~3": true,
+		"main^2": true,
+		"main@":  true,
+		"main$":  true,
+	}
+
+	for ref, want := range tests {
+		if got := isRefExpression(ref); got != want {
+			t.Errorf("isRefExpression(%q) = %t, want %t", ref, got, want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_repositories"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoriesDataSource{}
+
+func NewRepositoriesDataSource() datasource.DataSource {
+	return &RepositoriesDataSource{}
+}
+
+// RepositoriesDataSource defines the data source implementation.
+type RepositoriesDataSource struct {
+	dataSourceBase
+}
+
+func (d *RepositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *RepositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_repositories.RepositoriesDataSourceSchema(ctx)
+}
+
+func (d *RepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_repositories.RepositoriesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	results, err := client.listRepositories(ctx, lakefsapi.ListRepositoriesParams{
+		Prefix: optional(data.Prefix.ValueString()),
+		Search: optional(data.Search.ValueString()),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list repositories", err)
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue("repositories")
+	data.Repositories = make([]datasource_repositories.RepositoryModel, 0, len(results))
+	for _, repo := range results {
+		data.Repositories = append(data.Repositories, datasource_repositories.RepositoryModel{
+			Id:               types.StringValue(repo.ID),
+			CreationDate:     types.Int64Value(repo.CreationDate),
+			DefaultBranch:    types.StringValue(repo.DefaultBranch),
+			ReadOnly:         types.BoolValue(repo.ReadOnly),
+			StorageId:        types.StringValue(repo.StorageID),
+			StorageNamespace: types.StringValue(repo.StorageNamespace),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &RepositoryARNFunction{}
+
+// repositoryARNPrefix prefixes the ARNs of repositories and their objects and
+// branches in policy statements
+const repositoryARNPrefix = "arn:lakefs:fs:::repository/"
+
+func NewRepositoryARNFunction() function.Function {
+	return &RepositoryARNFunction{}
+}
+
+// RepositoryARNFunction defines the function implementation.
+type RepositoryARNFunction struct{}
+
+func (f *RepositoryARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "repository_arn"
+}
+
+func (f *RepositoryARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the policy resource ARN of a repository",
+		Description:         "Builds the arn:lakefs:fs:::repository/name ARN used in policy statements. The repository may be a name or a pattern with * and ? wildcards.",
+		MarkdownDescription: "Builds the `arn:lakefs:fs:::repository/name` ARN used in policy statements. The repository may be a name or a pattern with `*` and `?` wildcards.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "repository",
+				Description: "The name of the repository, or a wildcard pattern",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RepositoryARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var repository string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &repository))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := naming.ValidateRepositoryPattern(repository); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, repositoryARNPrefix+repository))
+}
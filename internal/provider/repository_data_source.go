@@ -5,7 +5,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,7 +21,7 @@ func NewRepositoryDataSource() datasource.DataSource {
 
 // RepositoryDataSource defines the data source implementation.
 type RepositoryDataSource struct {
-	client *LakeFSClient
+	dataSourceBase
 }
 
 func (d *RepositoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,23 +32,6 @@ func (d *RepositoryDataSource) Schema(ctx context.Context, req datasource.Schema
 	resp.Schema = datasource_repository.RepositoryDataSourceSchema(ctx)
 }
 
-func (d *RepositoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
-}
-
 func (d *RepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data datasource_repository.RepositoryModel
 
@@ -62,20 +44,19 @@ func (d *RepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	repoID := data.Repository.ValueString()
 
-	var result RepositoryResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s", repoID), &result)
+	result, err := client.GetRepositoryWithResponse(ctx, repoID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository: %s", err))
+		addClientError(&resp.Diagnostics, "read repository", err)
 		return
 	}
 
 	// Map response to state
-	data.Id = types.StringValue(result.ID)
-	data.StorageNamespace = types.StringValue(result.StorageNamespace)
-	data.StorageId = types.StringValue(result.StorageID)
-	data.DefaultBranch = types.StringValue(result.DefaultBranch)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	data.ReadOnly = types.BoolValue(result.ReadOnly)
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.StorageNamespace = types.StringValue(result.JSON200.StorageNamespace)
+	data.StorageId = types.StringValue(result.JSON200.StorageID)
+	data.DefaultBranch = types.StringValue(result.JSON200.DefaultBranch)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	data.ReadOnly = types.BoolValue(result.JSON200.ReadOnly)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
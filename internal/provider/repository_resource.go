@@ -6,17 +6,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_repository"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RepositoryResource{}
 var _ resource.ResourceWithImportState = &RepositoryResource{}
+var _ resource.ResourceWithModifyPlan = &RepositoryResource{}
 
 func NewRepositoryResource() resource.Resource {
 	return &RepositoryResource{}
@@ -24,27 +31,7 @@ func NewRepositoryResource() resource.Resource {
 
 // RepositoryResource defines the resource implementation.
 type RepositoryResource struct {
-	client *LakeFSClient
-}
-
-// RepositoryCreateRequest represents the request to create a repository
-type RepositoryCreateRequest struct {
-	Name             string `json:"name"`
-	StorageNamespace string `json:"storage_namespace"`
-	DefaultBranch    string `json:"default_branch,omitempty"`
-	SampleData       bool   `json:"sample_data,omitempty"`
-	ReadOnly         bool   `json:"read_only,omitempty"`
-}
-
-// RepositoryResponse represents the API response for a repository
-type RepositoryResponse struct {
-	ID               string `json:"id"`
-	Name             string `json:"name,omitempty"`
-	StorageNamespace string `json:"storage_namespace"`
-	StorageID        string `json:"storage_id,omitempty"`
-	DefaultBranch    string `json:"default_branch"`
-	CreationDate     int64  `json:"creation_date"`
-	ReadOnly         bool   `json:"read_only,omitempty"`
+	resourceBase
 }
 
 func (r *RepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,22 +42,89 @@ func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = resource_repository.RepositoryResourceSchema(ctx)
 }
 
-func (r *RepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+// ModifyPlan derives an omitted storage namespace from the server's default
+// namespace prefix, and checks new namespaces against the server's blockstore
+// so that a mismatch fails at plan time rather than during apply.
+func (r *RepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Existing repositories keep their namespace, and there is nothing to
+	// check on destroy
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	var plan, config resource_repository.RepositoryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var storage *lakefsapi.StorageConfig
+	if r.client != nil {
+		storage = r.client.StorageConfig()
+	}
+
+	// Namespaces are checked against the selected storage
+	if !config.StorageId.IsNull() && !config.StorageId.IsUnknown() && r.client != nil {
+		storages, err := listStorages(ctx, NewAPIClient(r.client))
+		if err != nil {
+			tflog.Warn(ctx, "Unable to list LakeFS storages", map[string]any{
+				"error": err.Error(),
+			})
+			storage = nil
+		} else {
+			storage = findStorage(storages, config.StorageId.ValueString())
+			if storage == nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("storage_id"),
+					"Invalid Storage ID",
+					fmt.Sprintf("The LakeFS server has no storage %q. Available storages: %s.",
+						config.StorageId.ValueString(), strings.Join(storageIDs(storages), ", ")),
+				)
+				return
+			}
+		}
+	}
+
+	if config.StorageNamespace.IsNull() {
+		if plan.Name.IsUnknown() {
+			return
+		}
+		// The configuration is missing when the server could not be queried,
+		// which is not a problem with the server's settings
+		if storage == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("storage_namespace"),
+				"Storage Configuration Unavailable",
+				"The LakeFS storage configuration is unavailable, so no storage namespace can be derived from the server's default_namespace_prefix. Set storage_namespace explicitly.",
+			)
+			return
+		}
+
+		namespace, ok := deriveStorageNamespace(storage, plan.Name.ValueString())
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("storage_namespace"),
+				"Missing Storage Namespace",
+				"The storage_namespace attribute is required because no default_namespace_prefix is configured for the selected LakeFS storage.",
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "Derived repository storage namespace", map[string]any{
+			"name":              plan.Name.ValueString(),
+			"storage_namespace": namespace,
+		})
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("storage_namespace"), namespace)...)
 		return
 	}
 
-	r.client = client
+	if config.StorageNamespace.IsUnknown() || storage == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(validateStorageNamespace(storage, config.StorageNamespace.ValueString())...)
 }
 
 func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -81,13 +135,26 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	createReq := RepositoryCreateRequest{
+	createReq := lakefsapi.RepositoryCreation{
 		Name:             data.Name.ValueString(),
 		StorageNamespace: data.StorageNamespace.ValueString(),
 	}
 
+	if !data.StorageId.IsNull() && !data.StorageId.IsUnknown() {
+		createReq.StorageID = data.StorageId.ValueString()
+	}
+
 	if !data.DefaultBranch.IsNull() && !data.DefaultBranch.IsUnknown() {
 		createReq.DefaultBranch = data.DefaultBranch.ValueString()
 	}
@@ -103,26 +170,26 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Debug(ctx, "Creating repository", map[string]any{
 		"name":              createReq.Name,
 		"storage_namespace": createReq.StorageNamespace,
+		"storage_id":        createReq.StorageID,
 	})
 
-	var result RepositoryResponse
-	err := client.Post(ctx, "/repositories", createReq, &result)
+	result, err := client.CreateRepositoryWithResponse(ctx, nil, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository: %s", err))
+		addClientError(&resp.Diagnostics, "create repository", err)
 		return
 	}
 
 	// Map response to state
-	data.Id = types.StringValue(result.ID)
-	data.Repository = types.StringValue(result.ID)
-	data.Name = types.StringValue(result.ID) // LakeFS uses ID as name
-	data.StorageNamespace = types.StringValue(result.StorageNamespace)
-	data.StorageId = types.StringValue(result.StorageID)
-	data.DefaultBranch = types.StringValue(result.DefaultBranch)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	data.ReadOnly = types.BoolValue(result.ReadOnly)
+	data.Id = types.StringValue(result.JSON201.ID)
+	data.Repository = types.StringValue(result.JSON201.ID)
+	data.Name = types.StringValue(result.JSON201.ID) // LakeFS uses ID as name
+	data.StorageNamespace = types.StringValue(result.JSON201.StorageNamespace)
+	data.StorageId = types.StringValue(result.JSON201.StorageID)
+	data.DefaultBranch = types.StringValue(result.JSON201.DefaultBranch)
+	data.CreationDate = types.Int64Value(result.JSON201.CreationDate)
+	data.ReadOnly = types.BoolValue(result.JSON201.ReadOnly)
 
-	tflog.Trace(ctx, "Created repository", map[string]any{"id": result.ID})
+	tflog.Trace(ctx, "Created repository", map[string]any{"id": result.JSON201.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -135,6 +202,15 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repoID := data.Id.ValueString()
@@ -142,26 +218,21 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		repoID = data.Name.ValueString()
 	}
 
-	var result RepositoryResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s", repoID), &result)
+	result, err := client.GetRepositoryWithResponse(ctx, repoID)
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository: %s", err))
+		readFailed(ctx, resp, "read repository", err)
 		return
 	}
 
 	// Map response to state
-	data.Id = types.StringValue(result.ID)
-	data.Repository = types.StringValue(result.ID)
-	data.Name = types.StringValue(result.ID)
-	data.StorageNamespace = types.StringValue(result.StorageNamespace)
-	data.StorageId = types.StringValue(result.StorageID)
-	data.DefaultBranch = types.StringValue(result.DefaultBranch)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	data.ReadOnly = types.BoolValue(result.ReadOnly)
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.Repository = types.StringValue(result.JSON200.ID)
+	data.Name = types.StringValue(result.JSON200.ID)
+	data.StorageNamespace = types.StringValue(result.JSON200.StorageNamespace)
+	data.StorageId = types.StringValue(result.JSON200.StorageID)
+	data.DefaultBranch = types.StringValue(result.JSON200.DefaultBranch)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	data.ReadOnly = types.BoolValue(result.JSON200.ReadOnly)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -187,6 +258,15 @@ func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repoID := data.Id.ValueString()
@@ -194,39 +274,166 @@ func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 		repoID = data.Name.ValueString()
 	}
 
-	tflog.Debug(ctx, "Deleting repository", map[string]any{"id": repoID})
-
-	err := client.Delete(ctx, fmt.Sprintf("/repositories/%s", repoID))
-	if err != nil {
-		if !IsNotFound(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository: %s", err))
+	if !data.ForceDestroy.ValueBool() {
+		contents, err := repositoryContents(ctx, client, repoID, data.DefaultBranch.ValueString())
+		if err != nil {
+			if IsNotFound(err) {
+				return
+			}
+			addClientError(&resp.Diagnostics, "check repository contents before deletion", err)
+			return
+		}
+		if len(contents) > 0 {
+			resp.Diagnostics.AddError(
+				"Repository Not Empty",
+				fmt.Sprintf("Refusing to delete repository %s because it has %s. "+
+					"To delete it anyway, set force_destroy = true and apply before destroying.", repoID, strings.Join(contents, ", ")),
+			)
 			return
 		}
 	}
 
+	tflog.Debug(ctx, "Deleting repository", map[string]any{
+		"id":    repoID,
+		"force": data.ForceDestroy.ValueBool(),
+	})
+
+	_, err := client.DeleteRepositoryWithResponse(ctx, repoID, &lakefsapi.DeleteRepositoryParams{
+		Force: optional(data.ForceDestroy.ValueBool()),
+	})
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, "delete repository", err)
+		return
+	}
+
 	tflog.Trace(ctx, "Deleted repository", map[string]any{"id": repoID})
 }
 
 func (r *RepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	client := NewAPIClient(r.client)
 
-	var result RepositoryResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s", req.ID), &result)
+	result, err := client.GetRepositoryWithResponse(ctx, req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import repository %s: %s", req.ID, err))
 		return
 	}
 
 	var data resource_repository.RepositoryModel
-	data.Id = types.StringValue(result.ID)
-	data.Repository = types.StringValue(result.ID)
-	data.Name = types.StringValue(result.ID)
-	data.StorageNamespace = types.StringValue(result.StorageNamespace)
-	data.StorageId = types.StringValue(result.StorageID)
-	data.DefaultBranch = types.StringValue(result.DefaultBranch)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	data.ReadOnly = types.BoolValue(result.ReadOnly)
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.Repository = types.StringValue(result.JSON200.ID)
+	data.Name = types.StringValue(result.JSON200.ID)
+	data.StorageNamespace = types.StringValue(result.JSON200.StorageNamespace)
+	data.StorageId = types.StringValue(result.JSON200.StorageID)
+	data.DefaultBranch = types.StringValue(result.JSON200.DefaultBranch)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	data.ReadOnly = types.BoolValue(result.JSON200.ReadOnly)
 	data.SampleData = types.BoolValue(false)
+	data.ForceDestroy = types.BoolValue(false)
+	data.Timeouts = nullTimeouts(resp.State)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// repositoryContents describes what deleting a repository would lose beyond
+// an empty repository: commits after the initial one on the default branch,
+// other branches, and uncommitted changes. It returns nil for an empty
+// repository.
+func repositoryContents(ctx context.Context, client *APIClient, repoID, defaultBranch string) ([]string, error) {
+	var contents []string
+
+	branches, err := client.listBranches(ctx, repoID, lakefsapi.ListBranchesParams{})
+	if err != nil {
+		return nil, err
+	}
+	var others []string
+	for _, branch := range branches {
+		if branch.ID != defaultBranch {
+			others = append(others, branch.ID)
+		}
+	}
+	if len(others) > 0 {
+		contents = append(contents, fmt.Sprintf("branches other than %s (%s)", defaultBranch, strings.Join(others, ", ")))
+	}
+
+	// The initial commit is created with the repository
+	log, err := client.LogCommitsWithResponse(ctx, repoID, defaultBranch, &lakefsapi.LogCommitsParams{Amount: optional(2), Limit: optional(true)})
+	if err != nil {
+		return nil, err
+	}
+	if len(log.JSON200.Results) > 1 {
+		contents = append(contents, fmt.Sprintf("commits on %s beyond the initial one", defaultBranch))
+	}
+
+	diff, err := client.DiffBranchWithResponse(ctx, repoID, defaultBranch, &lakefsapi.DiffBranchParams{Amount: optional(1)})
+	if err != nil {
+		return nil, err
+	}
+	if len(diff.JSON200.Results) > 0 {
+		contents = append(contents, fmt.Sprintf("uncommitted changes on %s", defaultBranch))
+	}
+
+	return contents, nil
+}
+
+// storageIDs returns the quoted IDs of storages
+func storageIDs(storages []lakefsapi.StorageConfig) []string {
+	ids := make([]string, 0, len(storages))
+	for _, storage := range storages {
+		ids = append(ids, fmt.Sprintf("%q", storage.BlockstoreID))
+	}
+	return ids
+}
+
+// deriveStorageNamespace returns the storage namespace for a repository under
+// the server's default namespace prefix. It returns false if the server has no
+// default prefix.
+func deriveStorageNamespace(storage *lakefsapi.StorageConfig, name string) (string, bool) {
+	if storage == nil || storage.DefaultNamespacePrefix == "" {
+		return "", false
+	}
+	return strings.TrimSuffix(storage.DefaultNamespacePrefix, "/") + "/" + name, true
+}
+
+// validateStorageNamespace checks that namespace can be used with the server's
+// blockstore: it must use the blockstore's scheme and match its validity
+// regex. A namespace outside the default namespace prefix is only a warning.
+func validateStorageNamespace(storage *lakefsapi.StorageConfig, namespace string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if example, err := url.Parse(storage.BlockstoreNamespaceExample); err == nil && example.Scheme != "" {
+		if scheme, _, _ := strings.Cut(namespace, "://"); scheme != example.Scheme {
+			diags.AddAttributeError(
+				path.Root("storage_namespace"),
+				"Invalid Storage Namespace",
+				fmt.Sprintf("The LakeFS server uses the %q blockstore, which expects storage namespaces such as %q, but got %q.",
+					storage.BlockstoreType, storage.BlockstoreNamespaceExample, namespace),
+			)
+			return diags
+		}
+	}
+
+	if storage.BlockstoreNamespaceValidityRegex != "" {
+		validity, err := regexp.Compile(storage.BlockstoreNamespaceValidityRegex)
+		if err == nil && !validity.MatchString(namespace) {
+			diags.AddAttributeError(
+				path.Root("storage_namespace"),
+				"Invalid Storage Namespace",
+				fmt.Sprintf("The storage namespace %q does not match the LakeFS server's namespace pattern %q.",
+					namespace, storage.BlockstoreNamespaceValidityRegex),
+			)
+			return diags
+		}
+	}
+
+	if storage.DefaultNamespacePrefix != "" && !strings.HasPrefix(namespace, storage.DefaultNamespacePrefix) {
+		diags.AddAttributeWarning(
+			path.Root("storage_namespace"),
+			"Storage Namespace Outside Default Prefix",
+			fmt.Sprintf("The storage namespace %q is not under the LakeFS server's default namespace prefix %q. "+
+				"Make sure LakeFS has access to it, or omit storage_namespace to create the repository under the default prefix.",
+				namespace, storage.DefaultNamespacePrefix),
+		)
+	}
+
+	return diags
+}
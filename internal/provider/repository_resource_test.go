@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_repository"
+)
+
+// testRepositoryServer serves the branches, commit log and uncommitted diff
+// of a repository
+func testRepositoryServer(branches []string, commits int, uncommitted bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var results []map[string]any
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/branches"):
+			for _, b := range branches {
+				results = append(results, map[string]any{"id": b, "commit_id": "c1"})
+			}
+		case strings.HasSuffix(r.URL.Path, "/commits"):
+			for i := 0; i < commits; i++ {
+				results = append(results, map[string]any{"id": "c"})
+			}
+		case strings.HasSuffix(r.URL.Path, "/diff"):
+			if uncommitted {
+				results = append(results, map[string]any{"path": "a", "type": "added"})
+			}
+		}
+		testWriteJSON(w, http.StatusOK, map[string]any{
+			"pagination": map[string]any{"has_more": false},
+			"results":    results,
+		})
+	}))
+}
+
+func TestRepositoryContentsEmpty(t *testing.T) {
+	server := testRepositoryServer([]string{"main"}, 1, false)
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	contents, err := repositoryContents(context.Background(), client, "repo", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(contents) != 0 {
+		t.Errorf("expected an empty repository, got: %v", contents)
+	}
+}
+
+func TestRepositoryContentsNotEmpty(t *testing.T) {
+	server := testRepositoryServer([]string{"main", "develop"}, 2, true)
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	contents, err := repositoryContents(context.Background(), client, "repo", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		"branches other than main (develop)",
+		"commits on main beyond the initial one",
+		"uncommitted changes on main",
+	}
+	if strings.Join(contents, "; ") != strings.Join(want, "; ") {
+		t.Errorf("unexpected contents\n got: %v\nwant: %v", contents, want)
+	}
+}
+
+func TestDeriveStorageNamespace(t *testing.T) {
+	namespace, ok := deriveStorageNamespace(&lakefsapi.StorageConfig{DefaultNamespacePrefix: "s3://bucket/lakefs/"}, "repo")
+	if !ok || namespace != "s3://bucket/lakefs/repo" {
+		t.Errorf("unexpected namespace: %q, %t", namespace, ok)
+	}
+
+	if _, ok := deriveStorageNamespace(&lakefsapi.StorageConfig{}, "repo"); ok {
+		t.Error("expected no namespace without a default prefix")
+	}
+	if _, ok := deriveStorageNamespace(nil, "repo"); ok {
+		t.Error("expected no namespace without a storage configuration")
+	}
+}
+
+func TestRepositoryPlanWithoutStorageNamespace(t *testing.T) {
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testWriteJSON(w, http.StatusForbidden, map[string]string{"message": "insufficient permissions"})
+	}))
+	defer unavailable.Close()
+
+	var policyRequests int
+	noPrefix := testServerInfoServer("1.38.0", true, true, &policyRequests)
+	defer noPrefix.Close()
+
+	tests := map[string]struct {
+		server  *httptest.Server
+		summary string
+	}{
+		"storage configuration unavailable": {unavailable, "Storage Configuration Unavailable"},
+		"no default namespace prefix":       {noPrefix, "Missing Storage Namespace"},
+	}
+
+	ctx := context.Background()
+	schema := resource_repository.RepositoryResourceSchema(ctx)
+	typ := schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := testObject(typ, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "repo")})
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &LakeFSClient{Endpoint: test.server.URL}
+			client.loadServerInfo(ctx)
+			r := &RepositoryResource{resourceBase{client: client}}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: config},
+				Plan:   tfsdk.Plan{Schema: schema, Raw: config},
+				State:  tfsdk.State{Schema: schema, Raw: tftypes.NewValue(typ, nil)},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != test.summary {
+				t.Errorf("expected a %q error, got: %v", test.summary, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateStorageNamespace(t *testing.T) {
+	storage := &lakefsapi.StorageConfig{
+		BlockstoreType:                   "s3",
+		BlockstoreNamespaceExample:       "s3://example-bucket/",
+		BlockstoreNamespaceValidityRegex: "^s3://",
+		DefaultNamespacePrefix:           "s3://bucket/lakefs",
+	}
+
+	tests := []struct {
+		namespace        string
+		errors, warnings int
+	}{
+		{"s3://bucket/lakefs/repo", 0, 0},
+		{"s3://other-bucket/repo", 0, 1},
+		{"https://account.blob.core.windows.net/container/repo", 1, 0},
+		{"local://repo", 1, 0},
+	}
+
+	for _, test := range tests {
+		diags := validateStorageNamespace(storage, test.namespace)
+		if diags.ErrorsCount() != test.errors || diags.WarningsCount() != test.warnings {
+			t.Errorf("%s: expected %d errors and %d warnings, got: %v", test.namespace, test.errors, test.warnings, diags)
+		}
+	}
+}
+
+func TestValidateStorageNamespaceRegex(t *testing.T) {
+	storage := &lakefsapi.StorageConfig{
+		BlockstoreType:                   "azure",
+		BlockstoreNamespaceExample:       "https://mystorageaccount.blob.core.windows.net/mycontainer/",
+		BlockstoreNamespaceValidityRegex: `^https?://[a-z0-9]+\.blob\.core\.windows\.net/`,
+	}
+
+	diags := validateStorageNamespace(storage, "https://example.com/container/repo")
+	if diags.ErrorsCount() != 1 || !strings.Contains(diags[0].Detail(), "namespace pattern") {
+		t.Errorf("expected a namespace pattern error, got: %v", diags)
+	}
+}
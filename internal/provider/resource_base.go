@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resourceBase is embedded by every resource. It receives the provider's
+// LakeFS client in Configure, so resources only implement their operations.
+type resourceBase struct {
+	client *LakeFSClient
+}
+
+func (r *resourceBase) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := providerClient(req.ProviderData, "Resource")
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+// dataSourceBase is embedded by every data source, like resourceBase
+type dataSourceBase struct {
+	client *LakeFSClient
+}
+
+func (d *dataSourceBase) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := providerClient(req.ProviderData, "Data Source")
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		d.client = client
+	}
+}
+
+// ephemeralResourceBase is embedded by every ephemeral resource, like
+// resourceBase
+type ephemeralResourceBase struct {
+	client *LakeFSClient
+}
+
+func (e *ephemeralResourceBase) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, diags := providerClient(req.ProviderData, "Ephemeral Resource")
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		e.client = client
+	}
+}
+
+// providerClient returns the LakeFS client passed by the provider to Configure.
+// It returns nil without diagnostics before the provider is configured, such
+// as during validation.
+func providerClient(providerData any, kind string) (*LakeFSClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if providerData == nil {
+		return nil, diags
+	}
+
+	client, ok := providerData.(*LakeFSClient)
+	if !ok {
+		diags.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", kind),
+			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil, diags
+	}
+
+	return client, diags
+}
+
+// addClientError reports a failed API call, describing what the provider was
+// unable to do, e.g. "read branch"
+func addClientError(diags *diag.Diagnostics, action string, err error) {
+	diags.AddError("Client Error", fmt.Sprintf("Unable to %s: %s", action, err))
+}
+
+// readFailed handles an error from reading a resource in Read. An object that
+// no longer exists is removed from state so that Terraform recreates it, and
+// any other error is reported.
+func readFailed(ctx context.Context, resp *resource.ReadResponse, action string, err error) {
+	if IsNotFound(err) {
+		tflog.Debug(ctx, "Resource no longer exists, removing it from state", map[string]any{
+			"error": err.Error(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	addClientError(&resp.Diagnostics, action, err)
+}
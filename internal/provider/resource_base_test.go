@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderClient(t *testing.T) {
+	client, diags := providerClient(nil, "Resource")
+	if client != nil || diags.HasError() {
+		t.Errorf("expected no client and no errors before configuration, got %v and %v", client, diags)
+	}
+
+	lakeFSClient := &LakeFSClient{Endpoint: "http://localhost:8000/api/v1"}
+	client, diags = providerClient(lakeFSClient, "Resource")
+	if client != lakeFSClient || diags.HasError() {
+		t.Errorf("expected the provider client, got %v and %v", client, diags)
+	}
+
+	client, diags = providerClient("unexpected", "Data Source")
+	if client != nil || diags.ErrorsCount() != 1 || diags[0].Summary() != "Unexpected Data Source Configure Type" {
+		t.Errorf("expected a configure type error, got %v and %v", client, diags)
+	}
+}
+
+// testResourceType returns the object type of a resource's schema
+func testResourceType(t *testing.T, server tfprotov6.ProviderServer, typeName string) tftypes.Object {
+	t.Helper()
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, ok := resp.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("no schema for %s", typeName)
+	}
+	return schema.ValueType().(tftypes.Object)
+}
+
+// testObject returns an object of typ with the given attributes, and the
+// others null
+func testObject(typ tftypes.Object, attrs ...map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for _, m := range attrs {
+		for name, value := range m {
+			values[name] = value
+		}
+	}
+	return tftypes.NewValue(typ, values)
+}
+
+// testDynamicValue encodes a value of typ
+func testDynamicValue(t *testing.T, typ tftypes.Object, value tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dv, err := tfprotov6.NewDynamicValue(typ, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &dv
+}
+
+// testPlanChange plans a change of a resource's configuration from oldConfig
+// to newConfig, where computed holds the computed attributes in state, with
+// an unconfigured provider. It returns the plan response and planned state.
+func testPlanChange(t *testing.T, typeName string, oldConfig, newConfig, computed map[string]tftypes.Value) (*tfprotov6.PlanResourceChangeResponse, tftypes.Value) {
+	t.Helper()
+
+	server := providerserver.NewProtocol6(New("test")())()
+	typ := testResourceType(t, server, typeName)
+
+	prior := testObject(typ, oldConfig, computed)
+	config := testObject(typ, newConfig)
+	// Terraform proposes the prior values of computed attributes
+	proposed := testObject(typ, computed, newConfig)
+
+	resp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       testDynamicValue(t, typ, prior),
+		ProposedNewState: testDynamicValue(t, typ, proposed),
+		Config:           testDynamicValue(t, typ, config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected plan diagnostics: %v", resp.Diagnostics[0])
+	}
+
+	planned, err := resp.PlannedState.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, planned
+}
+
+// testUpdateInPlace plans and applies a change of a resource's configuration
+// like testPlanChange, and checks that the resource is updated in place to a
+// fully known state that matches the plan. The update must not call the
+// LakeFS API.
+func testUpdateInPlace(t *testing.T, typeName string, oldConfig, newConfig, computed map[string]tftypes.Value) {
+	t.Helper()
+
+	planResp, planned := testPlanChange(t, typeName, oldConfig, newConfig, computed)
+	if len(planResp.RequiresReplace) > 0 {
+		t.Errorf("expected an in-place update, got replacement for %v", planResp.RequiresReplace)
+	}
+	if !planned.IsFullyKnown() {
+		t.Errorf("expected a fully known plan, got: %s", planned)
+	}
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+	typ := testResourceType(t, server, typeName)
+	prior := testObject(typ, oldConfig, computed)
+	config := testObject(typ, newConfig)
+
+	applyResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     typeName,
+		PriorState:   testDynamicValue(t, typ, prior),
+		PlannedState: planResp.PlannedState,
+		Config:       testDynamicValue(t, typ, config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applyResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected apply diagnostics: %v", applyResp.Diagnostics[0])
+	}
+
+	newState, err := applyResp.NewState.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !newState.Equal(planned) {
+		t.Errorf("expected the new state to match the plan\n got: %s\nwant: %s", newState, planned)
+	}
+}
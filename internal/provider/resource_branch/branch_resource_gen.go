@@ -4,10 +4,14 @@ package resource_branch
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func BranchResourceSchema(ctx context.Context) schema.Schema {
@@ -39,10 +43,16 @@ func BranchResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "The name of the branch",
 				MarkdownDescription: "The name of the branch",
+				Validators: []validator.String{
+					naming.BranchName(),
+				},
 			},
 			"repository": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					naming.RepositoryName(),
+				},
 			},
 			"source": schema.StringAttribute{
 				Required:            true,
@@ -50,16 +60,24 @@ func BranchResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Source reference to create the branch from",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type BranchModel struct {
-	Branch     types.String `tfsdk:"branch"`
-	CommitId   types.String `tfsdk:"commit_id"`
-	Force      types.Bool   `tfsdk:"force"`
-	Hidden     types.Bool   `tfsdk:"hidden"`
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Repository types.String `tfsdk:"repository"`
-	Source     types.String `tfsdk:"source"`
+	Branch     types.String   `tfsdk:"branch"`
+	CommitId   types.String   `tfsdk:"commit_id"`
+	Force      types.Bool     `tfsdk:"force"`
+	Hidden     types.Bool     `tfsdk:"hidden"`
+	Id         types.String   `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	Repository types.String   `tfsdk:"repository"`
+	Source     types.String   `tfsdk:"source"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
@@ -3,8 +3,15 @@ package resource_group
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func GroupResourceSchema(ctx context.Context) schema.Schema {
@@ -14,23 +21,43 @@ func GroupResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "The name of the group",
 				MarkdownDescription: "The name of the group",
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"description": schema.StringAttribute{
 				Optional:            true,
-				Description:         "A description of the group",
-				MarkdownDescription: "A description of the group",
+				Description:         "A description of the group. Changing this forces a new group to be created",
+				MarkdownDescription: "A description of the group. Changing this forces a new group to be created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"creation_date": schema.Int64Attribute{
 				Computed:            true,
 				Description:         "Unix Epoch in seconds",
 				MarkdownDescription: "Unix Epoch in seconds",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type GroupModel struct {
-	Id           types.String `tfsdk:"id"`
-	Description  types.String `tfsdk:"description"`
-	CreationDate types.Int64  `tfsdk:"creation_date"`
+	Id           types.String   `tfsdk:"id"`
+	Description  types.String   `tfsdk:"description"`
+	CreationDate types.Int64    `tfsdk:"creation_date"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
@@ -3,10 +3,14 @@ package resource_group_membership
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func GroupMembershipResourceSchema(ctx context.Context) schema.Schema {
@@ -14,21 +18,35 @@ func GroupMembershipResourceSchema(ctx context.Context) schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"group_id": schema.StringAttribute{
 				Required: true,
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"user_id": schema.StringAttribute{
 				Required: true,
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type GroupMembershipModel struct {
-	GroupId types.String `tfsdk:"group_id"`
-	UserId  types.String `tfsdk:"user_id"`
+	GroupId  types.String   `tfsdk:"group_id"`
+	UserId   types.String   `tfsdk:"user_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -3,16 +3,21 @@ package resource_group_policy_attachment
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 // GroupPolicyAttachmentModel describes the resource data model.
 type GroupPolicyAttachmentModel struct {
-	GroupId  types.String `tfsdk:"group_id"`
-	PolicyId types.String `tfsdk:"policy_id"`
+	GroupId  types.String   `tfsdk:"group_id"`
+	PolicyId types.String   `tfsdk:"policy_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func GroupPolicyAttachmentResourceSchema(ctx context.Context) schema.Schema {
@@ -20,16 +25,29 @@ func GroupPolicyAttachmentResourceSchema(ctx context.Context) schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"group_id": schema.StringAttribute{
 				Required: true,
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"policy_id": schema.StringAttribute{
 				Required: true,
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
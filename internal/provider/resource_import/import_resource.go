@@ -0,0 +1,158 @@
+package resource_import
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
+)
+
+func ImportResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Imports existing objects from object storage into a branch without copying them, and commits the result. Changing any argument runs a new import. Destroying the resource does not revert the import commit",
+		MarkdownDescription: "Imports existing objects from object storage into a branch without copying them, and commits the result. Changing any argument runs a new import. Destroying the resource does not revert the import commit",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the import job",
+				MarkdownDescription: "The ID of the import job",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the repository",
+				MarkdownDescription: "The name of the repository",
+				Validators: []validator.String{
+					naming.RepositoryName(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Required:            true,
+				Description:         "The branch to import into",
+				MarkdownDescription: "The branch to import into",
+				Validators: []validator.String{
+					naming.BranchName(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sources": schema.ListNestedAttribute{
+				Required:            true,
+				Description:         "The object storage locations to import",
+				MarkdownDescription: "The object storage locations to import",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Required:            true,
+							Description:         "The object storage URI to import (e.g., s3://bucket/tables/orders/)",
+							MarkdownDescription: "The object storage URI to import (e.g., `s3://bucket/tables/orders/`)",
+						},
+						"destination": schema.StringAttribute{
+							Required:            true,
+							Description:         "The path in the branch to import to (e.g., tables/orders/)",
+							MarkdownDescription: "The path in the branch to import to (e.g., `tables/orders/`)",
+						},
+						"type": schema.StringAttribute{
+							Required:            true,
+							Description:         "Whether path is a common_prefix or a single object",
+							MarkdownDescription: "Whether `path` is a `common_prefix` or a single `object`",
+							Validators: []validator.String{
+								stringvalidator.OneOf("common_prefix", "object"),
+							},
+						},
+					},
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				Required:            true,
+				Description:         "The message of the import commit",
+				MarkdownDescription: "The message of the import commit",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_metadata": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Metadata of the import commit",
+				MarkdownDescription: "Metadata of the import commit",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the import commit",
+				MarkdownDescription: "The ID of the import commit",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metarange_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The meta range ID of the import commit",
+				MarkdownDescription: "The meta range ID of the import commit",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ingested_objects": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of objects imported",
+				MarkdownDescription: "The number of objects imported",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type ImportModel struct {
+	Id              types.String   `tfsdk:"id"`
+	Repository      types.String   `tfsdk:"repository"`
+	Branch          types.String   `tfsdk:"branch"`
+	Sources         types.List     `tfsdk:"sources"`
+	CommitMessage   types.String   `tfsdk:"commit_message"`
+	CommitMetadata  types.Map      `tfsdk:"commit_metadata"`
+	CommitId        types.String   `tfsdk:"commit_id"`
+	MetarangeId     types.String   `tfsdk:"metarange_id"`
+	IngestedObjects types.Int64    `tfsdk:"ingested_objects"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type SourceModel struct {
+	Path        types.String `tfsdk:"path"`
+	Destination types.String `tfsdk:"destination"`
+	Type        types.String `tfsdk:"type"`
+}
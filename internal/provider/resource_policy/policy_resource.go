@@ -3,8 +3,13 @@ package resource_policy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func PolicyResourceSchema(ctx context.Context) schema.Schema {
@@ -14,8 +19,12 @@ func PolicyResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "The name of the policy",
 				MarkdownDescription: "The name of the policy",
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 			},
 			"statement": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
 				Required:            true,
 				Description:         "A JSON string defining actions, resources, and effect",
 				MarkdownDescription: "A JSON string defining actions, resources, and effect",
@@ -26,11 +35,20 @@ func PolicyResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Unix Epoch in seconds",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type PolicyModel struct {
-	Id           types.String `tfsdk:"id"`
-	Statement    types.String `tfsdk:"statement"`
-	CreationDate types.Int64  `tfsdk:"creation_date"`
+	Id           types.String         `tfsdk:"id"`
+	Statement    jsontypes.Normalized `tfsdk:"statement"`
+	CreationDate types.Int64          `tfsdk:"creation_date"`
+	Timeouts     timeouts.Value       `tfsdk:"timeouts"`
 }
@@ -4,13 +4,19 @@ package resource_repository
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func RepositoryResourceSchema(ctx context.Context) schema.Schema {
@@ -20,22 +26,38 @@ func RepositoryResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "Unix Epoch in seconds",
 				MarkdownDescription: "Unix Epoch in seconds",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"default_branch": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The default branch name (defaults to 'main')",
 				MarkdownDescription: "The default branch name (defaults to 'main')",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Allow destroying the repository when it has commits beyond the initial one, branches other than the default branch, or uncommitted changes. Also deletes read-only repositories. Must be applied before the destroy to take effect",
+				MarkdownDescription: "Allow destroying the repository when it has commits beyond the initial one, branches other than the default branch, or uncommitted changes. Also deletes read-only repositories. Must be applied before the destroy to take effect",
+				Default:             booldefault.StaticBool(false),
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
 				Description:         "The name of the repository",
 				MarkdownDescription: "The name of the repository",
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z0-9][a-z0-9-]{2,62}$"), ""),
+					naming.RepositoryName(),
 				},
 			},
 			"read_only": schema.BoolAttribute{
@@ -48,6 +70,9 @@ func RepositoryResourceSchema(ctx context.Context) schema.Schema {
 			"repository": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"sample_data": schema.BoolAttribute{
 				Optional: true,
@@ -57,29 +82,46 @@ func RepositoryResourceSchema(ctx context.Context) schema.Schema {
 			"storage_id": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Unique identifier of the underlying data store. *EXPERIMENTAL*",
-				MarkdownDescription: "Unique identifier of the underlying data store. *EXPERIMENTAL*",
+				Description:         "Unique identifier of the underlying data store, as listed by the lakefs_storages data source. Defaults to the server's default storage. Changing this forces a new repository. *EXPERIMENTAL*",
+				MarkdownDescription: "Unique identifier of the underlying data store, as listed by the `lakefs_storages` data source. Defaults to the server's default storage. Changing this forces a new repository. *EXPERIMENTAL*",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"storage_namespace": schema.StringAttribute{
-				Required:            true,
-				Description:         "Storage namespace URL (e.g., s3://bucket/path, gs://bucket/path, etc.)",
-				MarkdownDescription: "Storage namespace URL (e.g., s3://bucket/path, gs://bucket/path, etc.)",
+				Optional:            true,
+				Computed:            true,
+				Description:         "Storage namespace URL (e.g., s3://bucket/path, gs://bucket/path, etc.). Must match the server's blockstore. Defaults to the server's default_namespace_prefix followed by the repository name",
+				MarkdownDescription: "Storage namespace URL (e.g., s3://bucket/path, gs://bucket/path, etc.). Must match the server's blockstore. Defaults to the server's `default_namespace_prefix` followed by the repository name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexp.MustCompile("^(s3|gs|https?|mem|local|transient)://.*$"), ""),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type RepositoryModel struct {
-	CreationDate     types.Int64  `tfsdk:"creation_date"`
-	DefaultBranch    types.String `tfsdk:"default_branch"`
-	Id               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	ReadOnly         types.Bool   `tfsdk:"read_only"`
-	Repository       types.String `tfsdk:"repository"`
-	SampleData       types.Bool   `tfsdk:"sample_data"`
-	StorageId        types.String `tfsdk:"storage_id"`
-	StorageNamespace types.String `tfsdk:"storage_namespace"`
+	CreationDate     types.Int64    `tfsdk:"creation_date"`
+	DefaultBranch    types.String   `tfsdk:"default_branch"`
+	ForceDestroy     types.Bool     `tfsdk:"force_destroy"`
+	Id               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	ReadOnly         types.Bool     `tfsdk:"read_only"`
+	Repository       types.String   `tfsdk:"repository"`
+	SampleData       types.Bool     `tfsdk:"sample_data"`
+	StorageId        types.String   `tfsdk:"storage_id"`
+	StorageNamespace types.String   `tfsdk:"storage_namespace"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,94 @@
+package resource_setup
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
+)
+
+func SetupResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Performs the initial setup of a fresh LakeFS installation, creating the admin user and its credentials. Does nothing if the installation is already set up. Destroying the resource does not undo the setup",
+		MarkdownDescription: "Performs the initial setup of a fresh LakeFS installation, creating the admin user and its credentials. Does nothing if the installation is already set up. Destroying the resource does not undo the setup",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				Description:         "The username of the initial admin user",
+				MarkdownDescription: "The username of the initial admin user",
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_key_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The access key ID of the admin user. Generated by LakeFS if not set, and null if the installation was already set up without a provided key pair",
+				MarkdownDescription: "The access key ID of the admin user. Generated by LakeFS if not set, and null if the installation was already set up without a provided key pair",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_access_key")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The secret access key of the admin user. Generated by LakeFS if not set, and null if the installation was already set up without a provided key pair",
+				MarkdownDescription: "The secret access key of the admin user. Generated by LakeFS if not set, and null if the installation was already set up without a provided key pair",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("access_key_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"initialized": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether this resource performed the setup. False if the installation was already set up",
+				MarkdownDescription: "Whether this resource performed the setup. False if the installation was already set up",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type SetupModel struct {
+	Id              types.String   `tfsdk:"id"`
+	Username        types.String   `tfsdk:"username"`
+	AccessKeyId     types.String   `tfsdk:"access_key_id"`
+	SecretAccessKey types.String   `tfsdk:"secret_access_key"`
+	Initialized     types.Bool     `tfsdk:"initialized"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
@@ -4,50 +4,100 @@ package resource_tag
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func TagResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"commit_creation_date": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Creation date of the tagged commit, Unix Epoch in seconds",
+				MarkdownDescription: "Creation date of the tagged commit, Unix Epoch in seconds",
+			},
 			"commit_id": schema.StringAttribute{
-				Computed: true,
+				Computed:            true,
+				Description:         "The ID of the tagged commit",
+				MarkdownDescription: "The ID of the tagged commit",
+			},
+			"commit_message": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The message of the tagged commit",
+				MarkdownDescription: "The message of the tagged commit",
+			},
+			"committer": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The user that created the tagged commit",
+				MarkdownDescription: "The user that created the tagged commit",
 			},
 			"force": schema.BoolAttribute{
-				Optional: true,
-				Computed: true,
-				Default:  booldefault.StaticBool(false),
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "When true, a change of ref moves the tag to the new commit in place instead of replacing the resource, and creating the tag overwrites an existing tag with the same name",
+				MarkdownDescription: "When true, a change of `ref` moves the tag to the new commit in place instead of replacing the resource, and creating the tag overwrites an existing tag with the same name",
 			},
 			"id": schema.StringAttribute{
 				Required:            true,
 				Description:         "The tag name",
 				MarkdownDescription: "The tag name",
+				Validators: []validator.String{
+					naming.TagName(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"ref": schema.StringAttribute{
 				Required:            true,
-				Description:         "The commit reference to tag",
-				MarkdownDescription: "The commit reference to tag",
+				Description:         "The commit reference to tag. Unless force is set, changing it to a ref that resolves to another commit replaces the tag, and a ref only known during apply must resolve to the tagged commit",
+				MarkdownDescription: "The commit reference to tag. Unless `force` is set, changing it to a ref that resolves to another commit replaces the tag, and a ref only known during apply must resolve to the tagged commit",
 			},
 			"repository": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					naming.RepositoryName(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"tag": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type TagModel struct {
-	CommitId   types.String `tfsdk:"commit_id"`
-	Force      types.Bool   `tfsdk:"force"`
-	Id         types.String `tfsdk:"id"`
-	Ref        types.String `tfsdk:"ref"`
-	Repository types.String `tfsdk:"repository"`
-	Tag        types.String `tfsdk:"tag"`
+	CommitCreationDate types.Int64    `tfsdk:"commit_creation_date"`
+	CommitId           types.String   `tfsdk:"commit_id"`
+	CommitMessage      types.String   `tfsdk:"commit_message"`
+	Committer          types.String   `tfsdk:"committer"`
+	Force              types.Bool     `tfsdk:"force"`
+	Id                 types.String   `tfsdk:"id"`
+	Ref                types.String   `tfsdk:"ref"`
+	Repository         types.String   `tfsdk:"repository"`
+	Tag                types.String   `tfsdk:"tag"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
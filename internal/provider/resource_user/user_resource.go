@@ -3,8 +3,16 @@ package resource_user
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func UserResourceSchema(ctx context.Context) schema.Schema {
@@ -14,23 +22,64 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "The username of the user",
 				MarkdownDescription: "The username of the user",
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"creation_date": schema.Int64Attribute{
 				Computed:            true,
 				Description:         "Unix Epoch in seconds",
 				MarkdownDescription: "Unix Epoch in seconds",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Email address of the user. Mandatory when API authentication is enabled. Changing this forces a new user to be created",
+				MarkdownDescription: "Email address of the user. Mandatory when API authentication is enabled. Changing this forces a new user to be created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"friendly_name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "Friendly name of the user",
-				MarkdownDescription: "Friendly name of the user",
+				Description:         "Friendly name of the user. Changing this forces a new user to be created",
+				MarkdownDescription: "Friendly name of the user. Changing this forces a new user to be created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
+			"invite_user": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Send an invitation to the user's email address, which must be set, when creating the user. Only used on creation",
+				MarkdownDescription: "Send an invitation to the user's email address, which must be set, when creating the user. Only used on creation",
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type UserModel struct {
-	Id           types.String `tfsdk:"id"`
-	CreationDate types.Int64  `tfsdk:"creation_date"`
-	FriendlyName types.String `tfsdk:"friendly_name"`
+	Id           types.String   `tfsdk:"id"`
+	CreationDate types.Int64    `tfsdk:"creation_date"`
+	Email        types.String   `tfsdk:"email"`
+	FriendlyName types.String   `tfsdk:"friendly_name"`
+	InviteUser   types.Bool     `tfsdk:"invite_user"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
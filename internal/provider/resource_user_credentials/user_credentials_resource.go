@@ -3,19 +3,30 @@ package resource_user_credentials
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func UserCredentialsResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
+		Description:         "Manages an access key pair of a user. The secret access key is stored in state, encrypted if pgp_key is set. Terraform cannot return a secret from a managed resource without storing it in state, so to keep secrets out of state entirely use the lakefs_credentials ephemeral resource, whose key pair only lasts for one run",
+		MarkdownDescription: "Manages an access key pair of a user. The secret access key is stored in state, encrypted if `pgp_key` is set. Terraform cannot return a secret from a managed resource without storing it in state, so to keep secrets out of state entirely use the `lakefs_credentials` ephemeral resource, whose key pair only lasts for one run",
 		Attributes: map[string]schema.Attribute{
 			"user_id": schema.StringAttribute{
 				Required:            true,
 				Description:         "The ID of the user",
 				MarkdownDescription: "The ID of the user",
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -28,21 +39,117 @@ func UserCredentialsResourceSchema(ctx context.Context) schema.Schema {
 			"secret_access_key": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
-				Description:         "The secret access key",
-				MarkdownDescription: "The secret access key",
+				Description:         "The secret access key. Not set when pgp_key is used",
+				MarkdownDescription: "The secret access key. Not set when `pgp_key` is used",
+			},
+			"pgp_key": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A PGP public key, ASCII armored or base64 encoded binary, used to encrypt the secret access key. When set, the secret is only stored encrypted in encrypted_secret. Changing this forces new credentials to be created",
+				MarkdownDescription: "A PGP public key, ASCII armored or base64 encoded binary, used to encrypt the secret access key. When set, the secret is only stored encrypted in `encrypted_secret`. Changing this forces new credentials to be created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The fingerprint of the PGP key used to encrypt the secret",
+				MarkdownDescription: "The fingerprint of the PGP key used to encrypt the secret",
+			},
+			"encrypted_secret": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The secret access key encrypted with pgp_key, base64 encoded. Decrypt with `base64 -d | gpg --decrypt`",
+				MarkdownDescription: "The secret access key encrypted with `pgp_key`, base64 encoded. Decrypt with `base64 -d | gpg --decrypt`",
 			},
 			"creation_date": schema.Int64Attribute{
 				Computed:            true,
 				Description:         "Unix Epoch in seconds",
 				MarkdownDescription: "Unix Epoch in seconds",
 			},
+			"rotation": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "Rotates the key pair in place. A rotation mints a new key pair and keeps the previous one until the overlap has elapsed, so consumers can switch keys without downtime",
+				MarkdownDescription: "Rotates the key pair in place. A rotation mints a new key pair and keeps the previous one until the overlap has elapsed, so consumers can switch keys without downtime",
+				Attributes: map[string]schema.Attribute{
+					"rotate_after": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Rotate the key pair on the first apply after it is older than this duration (e.g., 720h)",
+						MarkdownDescription: "Rotate the key pair on the first apply after it is older than this duration (e.g., `720h`)",
+					},
+					"keepers": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Description:         "Arbitrary values that trigger a rotation whenever they change",
+						MarkdownDescription: "Arbitrary values that trigger a rotation whenever they change",
+					},
+					"overlap": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "How long the previous key pair stays valid after a rotation (defaults to 24h). The previous key is deleted on the first apply after the overlap has elapsed",
+						MarkdownDescription: "How long the previous key pair stays valid after a rotation (defaults to `24h`). The previous key is deleted on the first apply after the overlap has elapsed",
+						Default:             stringdefault.StaticString("24h"),
+					},
+				},
+			},
+			"rotated_at": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Unix Epoch in seconds of the last rotation, or of the creation of the first key pair",
+				MarkdownDescription: "Unix Epoch in seconds of the last rotation, or of the creation of the first key pair",
+			},
+			"previous_access_key_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The access key ID replaced by the last rotation, until the overlap has elapsed",
+				MarkdownDescription: "The access key ID replaced by the last rotation, until the overlap has elapsed",
+			},
+			"previous_secret_access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The secret access key replaced by the last rotation, until the overlap has elapsed",
+				MarkdownDescription: "The secret access key replaced by the last rotation, until the overlap has elapsed",
+			},
+			"previous_encrypted_secret": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The encrypted secret access key replaced by the last rotation, until the overlap has elapsed",
+				MarkdownDescription: "The encrypted secret access key replaced by the last rotation, until the overlap has elapsed",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type UserCredentialsModel struct {
-	UserId          types.String `tfsdk:"user_id"`
-	AccessKeyId     types.String `tfsdk:"access_key_id"`
-	SecretAccessKey types.String `tfsdk:"secret_access_key"`
-	CreationDate    types.Int64  `tfsdk:"creation_date"`
+	UserId                  types.String   `tfsdk:"user_id"`
+	AccessKeyId             types.String   `tfsdk:"access_key_id"`
+	SecretAccessKey         types.String   `tfsdk:"secret_access_key"`
+	PgpKey                  types.String   `tfsdk:"pgp_key"`
+	KeyFingerprint          types.String   `tfsdk:"key_fingerprint"`
+	EncryptedSecret         types.String   `tfsdk:"encrypted_secret"`
+	CreationDate            types.Int64    `tfsdk:"creation_date"`
+	Rotation                types.Object   `tfsdk:"rotation"`
+	RotatedAt               types.Int64    `tfsdk:"rotated_at"`
+	PreviousAccessKeyId     types.String   `tfsdk:"previous_access_key_id"`
+	PreviousSecretAccessKey types.String   `tfsdk:"previous_secret_access_key"`
+	PreviousEncryptedSecret types.String   `tfsdk:"previous_encrypted_secret"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+type RotationModel struct {
+	RotateAfter types.String `tfsdk:"rotate_after"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	Overlap     types.String `tfsdk:"overlap"`
+}
+
+// RotationAttrTypes returns the attribute types of the rotation object
+func RotationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rotate_after": types.StringType,
+		"keepers":      types.MapType{ElemType: types.StringType},
+		"overlap":      types.StringType,
+	}
 }
@@ -3,10 +3,14 @@ package resource_user_policy_attachment
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
 )
 
 func UserPolicyAttachmentResourceSchema(ctx context.Context) schema.Schema {
@@ -16,6 +20,9 @@ func UserPolicyAttachmentResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "The ID of the user",
 				MarkdownDescription: "The ID of the user",
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -24,15 +31,26 @@ func UserPolicyAttachmentResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "The ID of the policy",
 				MarkdownDescription: "The ID of the policy",
+				Validators: []validator.String{
+					naming.AuthID(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type UserPolicyAttachmentModel struct {
-	UserId   types.String `tfsdk:"user_id"`
-	PolicyId types.String `tfsdk:"policy_id"`
+	UserId   types.String   `tfsdk:"user_id"`
+	PolicyId types.String   `tfsdk:"policy_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
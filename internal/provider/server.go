@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// serverInfo caches what the provider knows about the LakeFS server
+type serverInfo struct {
+	// version and storage are loaded once by Configure, and are left empty
+	// when the server could not be queried
+	version *lakefsapi.VersionConfig
+	storage *lakefsapi.StorageConfig
+
+	// rbac is probed on first use, since only a few resources need it
+	rbacMu sync.Mutex
+	rbac   *bool
+}
+
+// loadServerInfo fetches the server version and storage configuration. Failures
+// are logged rather than reported, so that the provider still works against
+// servers or proxies that do not expose these endpoints.
+func (c *LakeFSClient) loadServerInfo(ctx context.Context) {
+	client := NewAPIClient(c)
+
+	version, err := client.GetLakeFSVersionWithResponse(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read LakeFS server version", map[string]any{
+			"error": err.Error(),
+		})
+	} else {
+		c.server.version = version.JSON200
+	}
+
+	storage, err := client.GetStorageConfigWithResponse(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read LakeFS storage configuration", map[string]any{
+			"error": err.Error(),
+		})
+	} else {
+		c.server.storage = storage.JSON200
+	}
+
+	tflog.Debug(ctx, "Loaded LakeFS server information", map[string]any{
+		"version":    c.ServerVersion(),
+		"blockstore": c.blockstoreType(),
+	})
+}
+
+// ServerVersion returns the LakeFS server version, or "" if it is unknown
+func (c *LakeFSClient) ServerVersion() string {
+	if c.server.version == nil {
+		return ""
+	}
+	return c.server.version.Version
+}
+
+// StorageConfig returns the LakeFS storage configuration, or nil if it is unknown
+func (c *LakeFSClient) StorageConfig() *lakefsapi.StorageConfig {
+	return c.server.storage
+}
+
+func (c *LakeFSClient) blockstoreType() string {
+	if c.server.storage == nil {
+		return ""
+	}
+	return c.server.storage.BlockstoreType
+}
+
+// SupportsRBAC reports whether the server manages policies. LakeFS
+// installations without RBAC answer policy requests with 501 Not Implemented.
+// The second return value is false if this could not be determined.
+func (c *LakeFSClient) SupportsRBAC(ctx context.Context) (bool, bool) {
+	c.server.rbacMu.Lock()
+	defer c.server.rbacMu.Unlock()
+
+	if c.server.rbac != nil {
+		return *c.server.rbac, true
+	}
+
+	client := NewAPIClient(c)
+	_, err := client.ListPoliciesWithResponse(ctx, &lakefsapi.ListPoliciesParams{Amount: optional(1)})
+	if err != nil && !IsNotImplemented(err) {
+		tflog.Warn(ctx, "Unable to determine whether LakeFS RBAC is enabled", map[string]any{
+			"error": err.Error(),
+		})
+		return false, false
+	}
+
+	supported := err == nil
+	c.server.rbac = &supported
+	return supported, true
+}
+
+// serverRequirement describes what a resource needs from the LakeFS server
+type serverRequirement struct {
+	// MinVersion is the oldest LakeFS version that supports the resource
+	MinVersion string
+	// RBAC is set for resources that manage policies
+	RBAC bool
+	// Import is set for resources that import from the object store
+	Import bool
+}
+
+// checkServerRequirement returns error diagnostics when the server cannot
+// support resourceType. Requirements that cannot be verified are skipped, as
+// the API call itself still fails with the server's error.
+func checkServerRequirement(ctx context.Context, client *LakeFSClient, resourceType string, req serverRequirement) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The provider is not configured when validating offline
+	if client == nil {
+		return diags
+	}
+
+	if req.MinVersion != "" {
+		version := client.ServerVersion()
+		if ok, known := versionAtLeast(version, req.MinVersion); known && !ok {
+			diags.AddError(
+				"Unsupported LakeFS Version",
+				fmt.Sprintf("%s requires lakeFS >= %s, but the server at %s runs lakeFS %s. Upgrade lakeFS to use it.",
+					resourceType, req.MinVersion, client.Endpoint, version),
+			)
+		}
+	}
+
+	if req.RBAC {
+		if supported, known := client.SupportsRBAC(ctx); known && !supported {
+			diags.AddError(
+				"RBAC Not Enabled",
+				fmt.Sprintf("%s requires RBAC-enabled installation, but the server at %s does not support managing policies. "+
+					"Use a lakeFS build with RBAC support, or remove it from the configuration.",
+					resourceType, client.Endpoint),
+			)
+		}
+	}
+
+	if req.Import {
+		if storage := client.StorageConfig(); storage != nil && !storage.ImportSupport {
+			diags.AddError(
+				"Import Not Supported",
+				fmt.Sprintf("%s requires a blockstore that supports importing, but the server at %s uses the %q blockstore, which does not.",
+					resourceType, client.Endpoint, storage.BlockstoreType),
+			)
+		}
+	}
+
+	return diags
+}
+
+// versionAtLeast reports whether version is at least minimum. The second return
+// value is false when version is not a release version, such as a dev build.
+func versionAtLeast(version, minimum string) (bool, bool) {
+	v, ok := parseVersion(version)
+	if !ok {
+		return false, false
+	}
+	m, ok := parseVersion(minimum)
+	if !ok {
+		return false, false
+	}
+
+	for i := range v {
+		if v[i] != m[i] {
+			return v[i] > m[i], true
+		}
+	}
+	return true, true
+}
+
+// parseVersion parses a version such as "1.38.0" or "v1.38.0-rc1" into its
+// major, minor and patch numbers
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) != len(parsed) {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}
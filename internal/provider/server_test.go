@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testServerInfoServer serves the version and storage configuration, and
+// answers policy requests as a LakeFS installation with or without RBAC
+func testServerInfoServer(version string, importSupport, rbac bool, policyRequests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/config/version":
+			testWriteJSON(w, http.StatusOK, map[string]any{"version": version})
+		case "/config/storage":
+			testWriteJSON(w, http.StatusOK, map[string]any{"blockstore_type": "local", "import_support": importSupport})
+		case "/auth/policies":
+			*policyRequests++
+			if !rbac {
+				testWriteJSON(w, http.StatusNotImplemented, map[string]string{"message": "Not implemented"})
+				return
+			}
+			testWriteJSON(w, http.StatusOK, map[string]any{
+				"pagination": map[string]any{"has_more": false},
+				"results":    []any{},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version, minimum string
+		ok, known        bool
+	}{
+		{"1.38.0", "1.0.0", true, true},
+		{"v1.0.0", "1.0.0", true, true},
+		{"1.2.3-rc1", "1.2.3", true, true},
+		{"0.98.0", "1.0.0", false, true},
+		{"1.9.0", "1.10.0", false, true},
+		{"dev-abc123", "1.0.0", false, false},
+		{"", "1.0.0", false, false},
+	}
+
+	for _, test := range tests {
+		ok, known := versionAtLeast(test.version, test.minimum)
+		if ok != test.ok || known != test.known {
+			t.Errorf("versionAtLeast(%q, %q) = %t, %t, expected %t, %t", test.version, test.minimum, ok, known, test.ok, test.known)
+		}
+	}
+}
+
+func TestCheckServerRequirementReportsMissingFeatures(t *testing.T) {
+	var policyRequests int
+	server := testServerInfoServer("0.98.0", false, false, &policyRequests)
+	defer server.Close()
+
+	client := &LakeFSClient{Endpoint: server.URL}
+	client.loadServerInfo(context.Background())
+
+	diags := checkServerRequirement(context.Background(), client, "lakefs_test", serverRequirement{MinVersion: "1.0.0", RBAC: true, Import: true})
+	if diags.ErrorsCount() != 3 {
+		t.Fatalf("expected 3 errors, got: %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "requires lakeFS >= 1.0.0") {
+		t.Errorf("unexpected version error: %s", diags[0].Detail())
+	}
+	if !strings.Contains(diags[1].Detail(), "requires RBAC-enabled installation") {
+		t.Errorf("unexpected RBAC error: %s", diags[1].Detail())
+	}
+
+	// The RBAC probe is cached
+	checkServerRequirement(context.Background(), client, "lakefs_test", serverRequirement{RBAC: true})
+	if policyRequests != 1 {
+		t.Errorf("expected 1 policy request, got %d", policyRequests)
+	}
+}
+
+func TestCheckServerRequirementSatisfied(t *testing.T) {
+	var policyRequests int
+	server := testServerInfoServer("1.38.0", true, true, &policyRequests)
+	defer server.Close()
+
+	client := &LakeFSClient{Endpoint: server.URL}
+	client.loadServerInfo(context.Background())
+
+	diags := checkServerRequirement(context.Background(), client, "lakefs_test", serverRequirement{MinVersion: "1.0.0", RBAC: true, Import: true})
+	if diags.HasError() {
+		t.Errorf("unexpected errors: %v", diags)
+	}
+}
+
+func TestCheckServerRequirementSkipsUnknownCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testWriteJSON(w, http.StatusForbidden, map[string]string{"message": "insufficient permissions"})
+	}))
+	defer server.Close()
+
+	client := &LakeFSClient{Endpoint: server.URL}
+	client.loadServerInfo(context.Background())
+
+	if client.ServerVersion() != "" || client.StorageConfig() != nil {
+		t.Errorf("expected unknown server information, got %q and %+v", client.ServerVersion(), client.StorageConfig())
+	}
+
+	diags := checkServerRequirement(context.Background(), client, "lakefs_test", serverRequirement{MinVersion: "1.0.0", RBAC: true, Import: true})
+	if diags.HasError() {
+		t.Errorf("unexpected errors: %v", diags)
+	}
+}
+
+func TestResourcesReportServerRequirements(t *testing.T) {
+	tests := []struct {
+		resource interface {
+			resource.ResourceWithConfigure
+			resource.ResourceWithModifyPlan
+		}
+		version string
+		errors  []string
+	}{
+		{&BranchProtectionResource{}, "1.2.0", []string{"requires lakeFS >= 1.3.0"}},
+		{&BranchProtectionResource{}, "1.3.0", nil},
+		{&ImportResource{}, "0.101.0", []string{"requires lakeFS >= 0.102.0", "does not"}},
+		{&GroupResource{}, "1.38.0", []string{"requires RBAC-enabled installation"}},
+		{&GroupMembershipResource{}, "1.38.0", []string{"requires RBAC-enabled installation"}},
+	}
+
+	for _, test := range tests {
+		var policyRequests int
+		server := testServerInfoServer(test.version, false, false, &policyRequests)
+
+		client := &LakeFSClient{Endpoint: server.URL}
+		client.loadServerInfo(context.Background())
+
+		var metadata resource.MetadataResponse
+		test.resource.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "lakefs"}, &metadata)
+		test.resource.Configure(context.Background(), resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+
+		req := resource.ModifyPlanRequest{Plan: tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})}}
+		var resp resource.ModifyPlanResponse
+		test.resource.ModifyPlan(context.Background(), req, &resp)
+		server.Close()
+
+		if resp.Diagnostics.ErrorsCount() != len(test.errors) {
+			t.Errorf("%s on lakeFS %s: expected %d errors, got: %v", metadata.TypeName, test.version, len(test.errors), resp.Diagnostics)
+			continue
+		}
+		for i, expected := range test.errors {
+			if !strings.Contains(resp.Diagnostics[i].Detail(), expected) {
+				t.Errorf("%s on lakeFS %s: unexpected error: %s", metadata.TypeName, test.version, resp.Diagnostics[i].Detail())
+			}
+		}
+	}
+}
+
+func TestDataSourcesReportMissingRBAC(t *testing.T) {
+	var policyRequests int
+	server := testServerInfoServer("1.38.0", true, false, &policyRequests)
+	defer server.Close()
+
+	ctx := context.Background()
+	client := &LakeFSClient{Endpoint: server.URL}
+	client.loadServerInfo(ctx)
+
+	for _, d := range []datasource.DataSourceWithConfigure{
+		&PolicyDataSource{},
+		&PoliciesDataSource{},
+		&GroupDataSource{},
+		&GroupsDataSource{},
+	} {
+		var metadata datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "lakefs"}, &metadata)
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &datasource.ConfigureResponse{})
+
+		var schema datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &schema)
+		config := tfsdk.Config{Schema: schema.Schema, Raw: testObject(schema.Schema.Type().TerraformType(ctx).(tftypes.Object))}
+
+		resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema.Schema}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+
+		if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != "RBAC Not Enabled" {
+			t.Errorf("%s: expected an RBAC error, got: %v", metadata.TypeName, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_setup"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SetupResource{}
+
+func NewSetupResource() resource.Resource {
+	return &SetupResource{}
+}
+
+// SetupResource defines the resource implementation.
+type SetupResource struct {
+	resourceBase
+}
+
+// setupStateInitialized is the setup state of an installation that is set up
+const setupStateInitialized = "initialized"
+
+func (r *SetupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setup"
+}
+
+func (r *SetupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_setup.SetupResourceSchema(ctx)
+}
+
+func (r *SetupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_setup.SetupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := NewAPIClient(r.client)
+
+	setupReq := lakefsapi.Setup{
+		Username: data.Username.ValueString(),
+	}
+	if !data.AccessKeyId.IsNull() && !data.AccessKeyId.IsUnknown() {
+		setupReq.Key = &lakefsapi.AccessKeyCredentials{
+			AccessKeyID:     data.AccessKeyId.ValueString(),
+			SecretAccessKey: data.SecretAccessKey.ValueString(),
+		}
+	}
+
+	tflog.Debug(ctx, "Setting up LakeFS", map[string]any{
+		"username":     setupReq.Username,
+		"provided_key": setupReq.Key != nil,
+	})
+
+	credentials, err := setupLakeFS(ctx, client, setupReq)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "set up LakeFS", err)
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(setupReq.Username)
+	data.Initialized = types.BoolValue(credentials != nil)
+	switch {
+	case credentials != nil:
+		data.AccessKeyId = types.StringValue(credentials.AccessKeyID)
+		data.SecretAccessKey = types.StringValue(credentials.SecretAccessKey)
+	case setupReq.Key == nil:
+		// The installation was set up before, and its key pair is unknown
+		data.AccessKeyId = types.StringNull()
+		data.SecretAccessKey = types.StringNull()
+	}
+
+	tflog.Trace(ctx, "Set up LakeFS", map[string]any{
+		"username":    setupReq.Username,
+		"initialized": credentials != nil,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SetupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_setup.SetupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := NewAPIClient(r.client)
+
+	setupState, err := client.GetSetupStateWithResponse(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read LakeFS setup state", err)
+		return
+	}
+
+	// An installation that was reset needs to be set up again
+	if setupState.JSON200.State != setupStateInitialized {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SetupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_setup.SetupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument requires replacement, so there is nothing to update
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SetupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_setup.SetupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// LakeFS cannot be un-setup, and the admin user is left in place
+	tflog.Debug(ctx, "Removing setup from state", map[string]any{
+		"username": data.Username.ValueString(),
+	})
+}
+
+// setupLakeFS sets up the installation unless it is already set up. It returns
+// the admin credentials, or nil if the installation was already set up.
+func setupLakeFS(ctx context.Context, client *APIClient, setupReq lakefsapi.Setup) (*lakefsapi.CredentialsWithSecret, error) {
+	setupState, err := client.GetSetupStateWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read setup state: %w", err)
+	}
+	if setupState.JSON200.State == setupStateInitialized {
+		return nil, nil
+	}
+
+	credentials, err := client.SetupWithResponse(ctx, setupReq)
+	if err != nil {
+		// Another client set up the installation in the meantime
+		if IsConflict(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return credentials.JSON200, nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// testSetupServer serves the setup endpoints of an installation. A setup
+// request answers with conflictStatus when it is non-zero.
+func testSetupServer(state string, conflictStatus int, setups *[]lakefsapi.Setup) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			testWriteJSON(w, http.StatusOK, lakefsapi.SetupState{State: lakefsapi.SetupStateState(state)})
+			return
+		}
+
+		var setupReq lakefsapi.Setup
+		_ = json.NewDecoder(r.Body).Decode(&setupReq)
+		*setups = append(*setups, setupReq)
+
+		if conflictStatus != 0 {
+			testWriteJSON(w, conflictStatus, map[string]string{"message": "lakeFS already initialized"})
+			return
+		}
+		testWriteJSON(w, http.StatusOK, lakefsapi.CredentialsWithSecret{AccessKeyID: "AKIAGENERATED", SecretAccessKey: "secret"})
+	}))
+}
+
+func TestSetupLakeFSInitializes(t *testing.T) {
+	var setups []lakefsapi.Setup
+	server := testSetupServer("not_initialized", 0, &setups)
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	credentials, err := setupLakeFS(context.Background(), client, lakefsapi.Setup{
+		Username: "admin",
+		Key:      &lakefsapi.AccessKeyCredentials{AccessKeyID: "AKIAPROVIDED", SecretAccessKey: "provided"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if credentials == nil || credentials.AccessKeyID != "AKIAGENERATED" {
+		t.Errorf("unexpected credentials: %+v", credentials)
+	}
+	if len(setups) != 1 || setups[0].Username != "admin" || setups[0].Key == nil || setups[0].Key.AccessKeyID != "AKIAPROVIDED" {
+		t.Errorf("unexpected setup requests: %+v", setups)
+	}
+}
+
+func TestSetupLakeFSAlreadyInitialized(t *testing.T) {
+	var setups []lakefsapi.Setup
+	server := testSetupServer("initialized", 0, &setups)
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	credentials, err := setupLakeFS(context.Background(), client, lakefsapi.Setup{Username: "admin"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if credentials != nil {
+		t.Errorf("expected no credentials, got: %+v", credentials)
+	}
+	if len(setups) != 0 {
+		t.Errorf("expected no setup requests, got: %+v", setups)
+	}
+}
+
+func TestSetupLakeFSConcurrentSetup(t *testing.T) {
+	var setups []lakefsapi.Setup
+	server := testSetupServer("not_initialized", http.StatusConflict, &setups)
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	credentials, err := setupLakeFS(context.Background(), client, lakefsapi.Setup{Username: "admin"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if credentials != nil {
+		t.Errorf("expected no credentials, got: %+v", credentials)
+	}
+}
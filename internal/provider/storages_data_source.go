@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_storages"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StoragesDataSource{}
+
+func NewStoragesDataSource() datasource.DataSource {
+	return &StoragesDataSource{}
+}
+
+// StoragesDataSource defines the data source implementation.
+type StoragesDataSource struct {
+	dataSourceBase
+}
+
+func (d *StoragesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storages"
+}
+
+func (d *StoragesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_storages.StoragesDataSourceSchema(ctx)
+}
+
+func (d *StoragesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_storages.StoragesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	storages, err := listStorages(ctx, client)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list storages", err)
+		return
+	}
+
+	data.Id = types.StringValue("storages")
+	data.Storages = make([]datasource_storages.StorageModel, 0, len(storages))
+	for _, storage := range storages {
+		data.Storages = append(data.Storages, datasource_storages.StorageModel{
+			Id:                     types.StringValue(storage.BlockstoreID),
+			Description:            types.StringValue(storage.BlockstoreDescription),
+			BlockstoreType:         types.StringValue(storage.BlockstoreType),
+			NamespaceExample:       types.StringValue(storage.BlockstoreNamespaceExample),
+			NamespaceValidityRegex: types.StringValue(storage.BlockstoreNamespaceValidityRegex),
+			DefaultNamespacePrefix: types.StringValue(storage.DefaultNamespacePrefix),
+			PreSignSupport:         types.BoolValue(storage.PreSignSupport),
+			ImportSupport:          types.BoolValue(storage.ImportSupport),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listStorages returns the storages configured on the server. Servers with a
+// single blockstore, or that predate multiple storages, report only their
+// storage configuration.
+func listStorages(ctx context.Context, client *APIClient) ([]lakefsapi.StorageConfig, error) {
+	config, err := client.GetConfigWithResponse(ctx)
+	if err != nil {
+		if !IsNotFound(err) {
+			return nil, err
+		}
+
+		storage, err := client.GetStorageConfigWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []lakefsapi.StorageConfig{*storage.JSON200}, nil
+	}
+
+	if len(config.JSON200.StorageConfigList) > 0 {
+		return config.JSON200.StorageConfigList, nil
+	}
+	if config.JSON200.StorageConfig != nil {
+		return []lakefsapi.StorageConfig{*config.JSON200.StorageConfig}, nil
+	}
+	return nil, nil
+}
+
+// findStorage returns the storage with the given ID, or nil if there is none
+func findStorage(storages []lakefsapi.StorageConfig, id string) *lakefsapi.StorageConfig {
+	for i := range storages {
+		if storages[i].BlockstoreID == id {
+			return &storages[i]
+		}
+	}
+	return nil
+}
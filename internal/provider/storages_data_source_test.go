@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListStoragesMultipleStorages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testWriteJSON(w, http.StatusOK, map[string]any{
+			"storage_config": map[string]any{"blockstore_type": "s3"},
+			"storage_config_list": []map[string]any{
+				{"blockstore_id": "primary", "blockstore_type": "s3"},
+				{"blockstore_id": "archive", "blockstore_type": "gs"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	storages, err := listStorages(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(storages) != 2 {
+		t.Fatalf("expected 2 storages, got: %+v", storages)
+	}
+
+	archive := findStorage(storages, "archive")
+	if archive == nil || archive.BlockstoreType != "gs" {
+		t.Errorf("unexpected archive storage: %+v", archive)
+	}
+	if findStorage(storages, "missing") != nil {
+		t.Error("did not expect to find a missing storage")
+	}
+}
+
+func TestListStoragesFallsBackToStorageConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/config/storage" {
+			testWriteJSON(w, http.StatusNotFound, map[string]string{"message": "not found"})
+			return
+		}
+		testWriteJSON(w, http.StatusOK, map[string]any{"blockstore_type": "local"})
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	storages, err := listStorages(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(storages) != 1 || storages[0].BlockstoreType != "local" || storages[0].BlockstoreID != "" {
+		t.Errorf("unexpected storages: %+v", storages)
+	}
+}
@@ -8,16 +8,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_tag"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TagResource{}
 var _ resource.ResourceWithImportState = &TagResource{}
+var _ resource.ResourceWithModifyPlan = &TagResource{}
 
 func NewTagResource() resource.Resource {
 	return &TagResource{}
@@ -25,19 +29,7 @@ func NewTagResource() resource.Resource {
 
 // TagResource defines the resource implementation.
 type TagResource struct {
-	client *LakeFSClient
-}
-
-// TagCreateRequest represents the request to create a tag
-type TagCreateRequest struct {
-	ID  string `json:"id"`
-	Ref string `json:"ref"`
-}
-
-// TagResponse represents the API response for a tag
-type TagResponse struct {
-	ID       string `json:"id"`
-	CommitID string `json:"commit_id"`
+	resourceBase
 }
 
 func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,23 +40,6 @@ func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	resp.Schema = resource_tag.TagResourceSchema(ctx)
 }
 
-func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
-}
-
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_tag.TagModel
 
@@ -73,15 +48,25 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repository := data.Repository.ValueString()
 	// In the generated schema, Id is the tag name (required field)
 	tagName := data.Id.ValueString()
 
-	createReq := TagCreateRequest{
-		ID:  tagName,
-		Ref: data.Ref.ValueString(),
+	createReq := lakefsapi.TagCreation{
+		ID:    tagName,
+		Ref:   data.Ref.ValueString(),
+		Force: data.Force.ValueBool(),
 	}
 
 	tflog.Debug(ctx, "Creating tag", map[string]any{
@@ -90,20 +75,22 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		"ref":        createReq.Ref,
 	})
 
-	var result TagResponse
-	err := client.Post(ctx, fmt.Sprintf("/repositories/%s/tags", repository), createReq, &result)
+	result, err := client.CreateTagWithResponse(ctx, repository, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag: %s", err))
+		addClientError(&resp.Diagnostics, "create tag", err)
 		return
 	}
 
 	// Map response to state - keep Id as tag name per the schema
-	data.CommitId = types.StringValue(result.CommitID)
 	data.Tag = types.StringValue(tagName)
+	resp.Diagnostics.Append(r.setTaggedCommit(ctx, client, &data, result.JSON201.CommitID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created tag", map[string]any{
 		"id":        data.Id.ValueString(),
-		"commit_id": result.CommitID,
+		"commit_id": result.JSON201.CommitID,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -117,6 +104,15 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repository := data.Repository.ValueString()
@@ -126,32 +122,161 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		tagName = data.Tag.ValueString()
 	}
 
-	var result TagResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName), &result)
+	result, err := client.GetTagWithResponse(ctx, repository, tagName)
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tag: %s", err))
+		readFailed(ctx, resp, "read tag", err)
 		return
 	}
 
 	// Map response to state
-	data.CommitId = types.StringValue(result.CommitID)
+	resp.Diagnostics.Append(r.setTaggedCommit(ctx, client, &data, result.JSON200.CommitID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *TagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resource_tag.TagModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A tag moving to another repository is replaced, and the ref is
+	// resolved in the new repository on create
+	if !plan.Repository.Equal(state.Repository) {
+		return
+	}
+
+	// An unknown ref is resolved by Update, which leaves the commit attributes
+	// unknown until then
+	if plan.Ref.IsUnknown() {
+		return
+	}
+
+	if !plan.Ref.Equal(state.Ref) {
+		// A different ref expression may still name the tagged commit, e.g.
+		// a branch name replacing the commit ID recorded by an import
+		commit, err := resolveRefCommit(ctx, NewAPIClient(r.client), state.Repository.ValueString(), plan.Ref.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ref"), "Client Error", fmt.Sprintf("Unable to resolve ref: %s", err))
+			return
+		}
+
+		if commit.ID != state.CommitId.ValueString() {
+			tflog.Debug(ctx, "Tag ref resolves to a new commit", map[string]any{
+				"tag":       state.Id.ValueString(),
+				"commit_id": commit.ID,
+				"force":     plan.Force.ValueBool(),
+			})
+			if !plan.Force.ValueBool() {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root("ref"))
+			}
+			// Leave the commit attributes unknown, Update retargets the tag
+			return
+		}
+	}
+
+	// The tag stays on the same commit
+	plan.Tag = state.Tag
+	plan.CommitId = state.CommitId
+	plan.CommitMessage = state.CommitMessage
+	plan.Committer = state.Committer
+	plan.CommitCreationDate = state.CommitCreationDate
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_tag.TagModel
+	var data, state resource_tag.TagModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Tags in LakeFS are immutable
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// ModifyPlan keeps the commit known when the tag does not move
+	if !data.CommitId.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	client := NewAPIClient(r.client)
+
+	repository := data.Repository.ValueString()
+	tagName := data.Id.ValueString()
+
+	// A ref that was unknown during plan may still name the tagged commit.
+	// Otherwise it can only move the tag with force, since replacing the tag
+	// is no longer possible once the apply has started.
+	if !data.Force.ValueBool() {
+		commit, err := resolveRefCommit(ctx, client, repository, data.Ref.ValueString())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "resolve ref", err)
+			return
+		}
+		if commit.ID != state.CommitId.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ref"),
+				"Tag Cannot Move",
+				fmt.Sprintf("The ref %q resolves to commit %s, but tag %s is on commit %s. The ref was only known during apply, so the tag cannot be replaced. "+
+					"Set force = true to move the tag in place, or replace it with terraform apply -replace.",
+					data.Ref.ValueString(), commit.ID, tagName, state.CommitId.ValueString()),
+			)
+			return
+		}
+
+		data.Tag = state.Tag
+		data.CommitId = state.CommitId
+		data.CommitMessage = state.CommitMessage
+		data.Committer = state.Committer
+		data.CommitCreationDate = state.CommitCreationDate
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Debug(ctx, "Retargeting tag", map[string]any{
+		"repository":      repository,
+		"tag":             tagName,
+		"ref":             data.Ref.ValueString(),
+		"previous_commit": state.CommitId.ValueString(),
+	})
+
+	result, err := retargetTag(ctx, client, repository, tagName, data.Ref.ValueString(), state.CommitId.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "retarget tag", err)
+		return
+	}
+
+	data.Tag = types.StringValue(tagName)
+	resp.Diagnostics.Append(r.setTaggedCommit(ctx, client, &data, result.CommitID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Retargeted tag", map[string]any{
+		"id":        tagName,
+		"commit_id": result.CommitID,
+	})
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -163,6 +288,15 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
 	repository := data.Repository.ValueString()
@@ -177,12 +311,10 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		"tag":        tagName,
 	})
 
-	err := client.Delete(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName))
-	if err != nil {
-		if !IsNotFound(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag: %s", err))
-			return
-		}
+	_, err := client.DeleteTagWithResponse(ctx, repository, tagName)
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, "delete tag", err)
+		return
 	}
 
 	tflog.Trace(ctx, "Deleted tag", map[string]any{
@@ -206,8 +338,7 @@ func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateR
 	repository := parts[0]
 	tagName := parts[1]
 
-	var result TagResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName), &result)
+	result, err := client.GetTagWithResponse(ctx, repository, tagName)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import tag %s: %s", req.ID, err))
 		return
@@ -217,9 +348,66 @@ func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateR
 	data.Id = types.StringValue(tagName) // Id is the tag name
 	data.Repository = types.StringValue(repository)
 	data.Tag = types.StringValue(tagName)
-	data.CommitId = types.StringValue(result.CommitID)
-	data.Ref = types.StringValue(result.CommitID) // Use commit ID as ref
-	data.Force = types.BoolValue(false)
+	data.Ref = types.StringValue(result.JSON200.CommitID) // Use commit ID as ref
+	// force only controls how changes are applied, so it is taken from the
+	// configuration on the next apply
+	data.Force = types.BoolNull()
+	data.Timeouts = nullTimeouts(resp.State)
+	resp.Diagnostics.Append(r.setTaggedCommit(ctx, client, &data, result.JSON200.CommitID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// setTaggedCommit reads the tagged commit and sets the commit attributes
+func (r *TagResource) setTaggedCommit(ctx context.Context, client *APIClient, data *resource_tag.TagModel, commitID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	commit, err := client.GetCommitWithResponse(ctx, data.Repository.ValueString(), commitID)
+	if err != nil {
+		addClientError(&diags, fmt.Sprintf("read tagged commit %s", commitID), err)
+		return diags
+	}
+
+	data.CommitId = types.StringValue(commitID)
+	data.CommitMessage = types.StringValue(commit.JSON200.Message)
+	data.Committer = types.StringValue(commit.JSON200.Committer)
+	data.CommitCreationDate = types.Int64Value(commit.JSON200.CreationDate)
+
+	return diags
+}
+
+// retargetTag points an existing tag at ref. LakeFS overwrites the tag in a
+// single call when force is set. Servers without support for it answer with a
+// conflict, in which case the tag is deleted and recreated, and restored to
+// its previous commit if the recreation fails.
+func retargetTag(ctx context.Context, client *APIClient, repository, tag, ref, previousCommitID string) (*lakefsapi.Ref, error) {
+	result, err := client.CreateTagWithResponse(ctx, repository, lakefsapi.TagCreation{ID: tag, Ref: ref, Force: true})
+	if err == nil {
+		return result.JSON201, nil
+	}
+	if !IsConflict(err) {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Tag overwrite not supported, deleting and recreating tag", map[string]any{
+		"repository": repository,
+		"tag":        tag,
+	})
+
+	if _, err := client.DeleteTagWithResponse(ctx, repository, tag); err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	result, err = client.CreateTagWithResponse(ctx, repository, lakefsapi.TagCreation{ID: tag, Ref: ref})
+	if err != nil {
+		if _, restoreErr := client.CreateTagWithResponse(ctx, repository, lakefsapi.TagCreation{ID: tag, Ref: previousCommitID}); restoreErr != nil {
+			return nil, fmt.Errorf("%w (restoring the tag to %s also failed: %s)", err, previousCommitID, restoreErr)
+		}
+		return nil, err
+	}
+
+	return result.JSON201, nil
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_tag"
+)
+
+func TestRetargetTagFallsBackToDeleteAndCreate(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body lakefsapi.TagCreation
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodPost && body.Force:
+			testWriteJSON(w, http.StatusConflict, map[string]string{"message": "tag already exists"})
+		case r.Method == http.MethodPost:
+			testWriteJSON(w, http.StatusCreated, lakefsapi.Ref{ID: body.ID, CommitID: "c2"})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	result, err := retargetTag(context.Background(), client, "repo", "prod-current", "main", "c1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.CommitID != "c2" {
+		t.Errorf("expected the tag to point to c2, got %s", result.CommitID)
+	}
+
+	want := []string{
+		"POST /repositories/repo/tags",
+		"DELETE /repositories/repo/tags/prod-current",
+		"POST /repositories/repo/tags",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("call %d: got %q, want %q", i, calls[i], want[i])
+		}
+	}
+}
+
+func TestRetargetTagRestoresPreviousCommit(t *testing.T) {
+	var restored string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body lakefsapi.TagCreation
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		switch {
+		case r.Method == http.MethodPost && body.Force:
+			testWriteJSON(w, http.StatusConflict, map[string]string{"message": "tag already exists"})
+		case r.Method == http.MethodPost && body.Ref == "c1":
+			restored = body.Ref
+			testWriteJSON(w, http.StatusCreated, lakefsapi.Ref{ID: body.ID, CommitID: "c1"})
+		case r.Method == http.MethodPost:
+			testWriteJSON(w, http.StatusNotFound, map[string]string{"message": "ref not found"})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(&LakeFSClient{Endpoint: server.URL})
+
+	if _, err := retargetTag(context.Background(), client, "repo", "prod-current", "missing", "c1"); err == nil {
+		t.Fatal("expected an error for a ref that cannot be tagged")
+	}
+	if restored != "c1" {
+		t.Errorf("expected the tag to be restored to c1, got %q", restored)
+	}
+}
+
+// testTagComputed returns the computed attributes of a tag on commit c1
+func testTagComputed() map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"tag":                  tftypes.NewValue(tftypes.String, "v1"),
+		"force":                tftypes.NewValue(tftypes.Bool, false),
+		"commit_id":            tftypes.NewValue(tftypes.String, "c1"),
+		"commit_message":       tftypes.NewValue(tftypes.String, "Initial commit"),
+		"committer":            tftypes.NewValue(tftypes.String, "admin"),
+		"commit_creation_date": tftypes.NewValue(tftypes.Number, big.NewFloat(1700000000)),
+	}
+}
+
+func TestTagPlanSkipsRefResolutionWhenRepositoryChanges(t *testing.T) {
+	oldConfig := map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "v1"),
+		"repository": tftypes.NewValue(tftypes.String, "repo"),
+		"ref":        tftypes.NewValue(tftypes.String, "main"),
+	}
+	newConfig := map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "v1"),
+		"repository": tftypes.NewValue(tftypes.String, "other"),
+		"ref":        tftypes.NewValue(tftypes.String, "develop"),
+	}
+
+	// The provider is not configured, so resolving the ref would fail
+	resp, _ := testPlanChange(t, "lakefs_tag", oldConfig, newConfig, testTagComputed())
+
+	repository := tftypes.NewAttributePath().WithAttributeName("repository")
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(repository) {
+		t.Errorf("expected the repository to force replacement, got: %v", resp.RequiresReplace)
+	}
+}
+
+func TestTagPlanKeepsUnknownRef(t *testing.T) {
+	oldConfig := map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "v1"),
+		"repository": tftypes.NewValue(tftypes.String, "repo"),
+		"ref":        tftypes.NewValue(tftypes.String, "main"),
+	}
+	newConfig := map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "v1"),
+		"repository": tftypes.NewValue(tftypes.String, "repo"),
+		"ref":        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+
+	resp, planned := testPlanChange(t, "lakefs_tag", oldConfig, newConfig, testTagComputed())
+	if len(resp.RequiresReplace) > 0 {
+		t.Errorf("expected an in-place update, got replacement for %v", resp.RequiresReplace)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := planned.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+	if attrs["commit_id"].IsKnown() {
+		t.Errorf("expected the commit to be unknown until the ref is, got: %s", attrs["commit_id"])
+	}
+}
+
+func TestTagUpdateResolvesRefUnknownDuringPlan(t *testing.T) {
+	tests := map[string]struct {
+		commit  string
+		success bool
+	}{
+		"same commit":  {commit: "c1", success: true},
+		"other commit": {commit: "c2", success: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var tagged bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/tags") {
+					tagged = true
+				}
+				testWriteJSON(w, http.StatusOK, map[string]any{
+					"pagination": map[string]any{"has_more": false},
+					"results":    []lakefsapi.Commit{{ID: tt.commit}},
+				})
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			schema := resource_tag.TagResourceSchema(ctx)
+			state := tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)}
+			plan := tfsdk.Plan{Schema: schema, Raw: state.Raw}
+
+			prior := resource_tag.TagModel{
+				Id:                 types.StringValue("v1"),
+				Repository:         types.StringValue("repo"),
+				Ref:                types.StringValue("main"),
+				Tag:                types.StringValue("v1"),
+				Force:              types.BoolValue(false),
+				CommitId:           types.StringValue("c1"),
+				CommitMessage:      types.StringValue("Initial commit"),
+				Committer:          types.StringValue("admin"),
+				CommitCreationDate: types.Int64Value(1700000000),
+				Timeouts:           nullTimeouts(state),
+			}
+			planned := prior
+			planned.Ref = types.StringValue("release")
+			planned.Tag = types.StringUnknown()
+			planned.CommitId = types.StringUnknown()
+			planned.CommitMessage = types.StringUnknown()
+			planned.Committer = types.StringUnknown()
+			planned.CommitCreationDate = types.Int64Unknown()
+
+			if diags := state.Set(ctx, &prior); diags.HasError() {
+				t.Fatalf("unexpected error setting state: %v", diags)
+			}
+			if diags := plan.Set(ctx, &planned); diags.HasError() {
+				t.Fatalf("unexpected error setting plan: %v", diags)
+			}
+
+			r := &TagResource{resourceBase{client: &LakeFSClient{Endpoint: server.URL}}}
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			if tagged {
+				t.Error("expected the tag not to move without force")
+			}
+			if resp.Diagnostics.HasError() == tt.success {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !tt.success {
+				return
+			}
+
+			var got resource_tag.TagModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.CommitId.ValueString() != "c1" || got.Ref.ValueString() != "release" {
+				t.Errorf("expected the tag to stay on c1 with the new ref, got %s and %s", got.CommitId, got.Ref)
+			}
+		})
+	}
+}
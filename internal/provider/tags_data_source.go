@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_tags"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagsDataSource{}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+// TagsDataSource defines the data source implementation.
+type TagsDataSource struct {
+	dataSourceBase
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_tags.TagsDataSourceSchema(ctx)
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_tags.TagsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	repository := data.Repository.ValueString()
+
+	results, err := client.listTags(ctx, repository, lakefsapi.ListTagsParams{Prefix: optional(data.Prefix.ValueString())})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list tags", err)
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(repository)
+	data.Tags = make([]datasource_tags.TagModel, 0, len(results))
+	for _, tag := range results {
+		data.Tags = append(data.Tags, datasource_tags.TagModel{
+			Id:       types.StringValue(tag.ID),
+			CommitId: types.StringValue(tag.CommitID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
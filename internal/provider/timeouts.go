@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTimeout is the timeout of resource operations that have no timeout
+// configured in their timeouts block
+const defaultTimeout = 20 * time.Minute
+
+// nullTimeouts returns a null timeouts value typed after the timeouts block of
+// the state schema. A zero timeouts.Value does not match the block type, so
+// ImportState functions that build a complete model use this instead.
+func nullTimeouts(state tfsdk.State) timeouts.Value {
+	attrTypes := map[string]attr.Type{}
+	if block, ok := state.Schema.GetBlocks()["timeouts"]; ok {
+		if t, ok := block.Type().(timeouts.Type); ok {
+			attrTypes = t.AttrTypes
+		}
+	}
+
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
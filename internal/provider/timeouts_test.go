@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_tag"
+)
+
+func TestNullTimeoutsMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	schema := resource_tag.TagResourceSchema(ctx)
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+
+	data := resource_tag.TagModel{
+		Id:       types.StringValue("v1"),
+		Timeouts: nullTimeouts(state),
+	}
+
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error setting state: %v", diags)
+	}
+}
+
+func TestTimeoutsOnlyChangeUpdatesInPlace(t *testing.T) {
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+	num := func(n int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, big.NewFloat(float64(n))) }
+	boolean := func(b bool) tftypes.Value { return tftypes.NewValue(tftypes.Bool, b) }
+	sourceType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"path": tftypes.String, "destination": tftypes.String, "type": tftypes.String,
+	}}
+
+	tests := map[string]struct {
+		// config holds the configured attributes, state the computed ones
+		config, state map[string]tftypes.Value
+	}{
+		"lakefs_group": {
+			config: map[string]tftypes.Value{"id": str("developers")},
+			state:  map[string]tftypes.Value{"creation_date": num(1700000000)},
+		},
+		"lakefs_user": {
+			config: map[string]tftypes.Value{"id": str("jane")},
+			state: map[string]tftypes.Value{
+				"creation_date": num(1700000000),
+				"email":         str("jane@example.com"),
+				"friendly_name": str("Jane"),
+				"invite_user":   boolean(false),
+			},
+		},
+		"lakefs_import": {
+			config: map[string]tftypes.Value{
+				"repository":     str("repo"),
+				"branch":         str("main"),
+				"commit_message": str("Import orders"),
+				"sources": tftypes.NewValue(tftypes.List{ElementType: sourceType}, []tftypes.Value{
+					tftypes.NewValue(sourceType, map[string]tftypes.Value{
+						"path":        str("s3://bucket/orders/"),
+						"destination": str("orders/"),
+						"type":        str("common_prefix"),
+					}),
+				}),
+			},
+			state: map[string]tftypes.Value{
+				"id":               str("import-1"),
+				"commit_id":        str("c1"),
+				"metarange_id":     str("m1"),
+				"ingested_objects": num(42),
+			},
+		},
+	}
+
+	timeouts := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"create": tftypes.String,
+		"read":   tftypes.String,
+		"delete": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"create": str("30m"),
+		"read":   tftypes.NewValue(tftypes.String, nil),
+		"delete": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	for typeName, tt := range tests {
+		t.Run(typeName, func(t *testing.T) {
+			config := map[string]tftypes.Value{"timeouts": timeouts}
+			for name, value := range tt.config {
+				config[name] = value
+			}
+			testUpdateInPlace(t, typeName, tt.config, config, tt.state)
+		})
+	}
+}
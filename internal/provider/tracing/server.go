@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// typeNameKey is the span attribute holding the resource, data source or
+	// ephemeral resource type of an operation
+	typeNameKey = attribute.Key("terraform.type_name")
+	// operationKey is the span attribute holding the operation Terraform
+	// called, such as Plan or Create
+	operationKey = attribute.Key("terraform.operation")
+)
+
+// msgPackNull is the MessagePack encoding of a null value, which Terraform
+// sends as the prior state of a create and the planned state of a delete
+var msgPackNull = []byte{0xc0}
+
+// WrapServer returns a provider server factory whose servers trace the
+// operations Terraform calls on resources, data sources and ephemeral
+// resources. Other calls are passed through as is. The spans are part of
+// the trace in the TRACEPARENT environment variable, if set.
+func WrapServer(factory func() tfprotov6.ProviderServer) func() tfprotov6.ProviderServer {
+	parent := environmentParent()
+	return func() tfprotov6.ProviderServer {
+		return &server{ProviderServer: factory(), parent: parent}
+	}
+}
+
+// server traces the operations of the embedded provider server
+type server struct {
+	tfprotov6.ProviderServer
+
+	parent trace.SpanContext
+}
+
+// start starts the span of an operation
+func (s *server) start(ctx context.Context, typeName, operation string) (context.Context, trace.Span) {
+	if s.parent.IsValid() && !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, s.parent)
+	}
+	return Tracer().Start(ctx, typeName+" "+operation, trace.WithAttributes(
+		typeNameKey.String(typeName),
+		operationKey.String(operation),
+	))
+}
+
+// end ends the span of an operation, which failed if it returned an error or
+// error diagnostics
+func end(span trace.Span, diagnostics []*tfprotov6.Diagnostic, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	for _, diagnostic := range diagnostics {
+		if diagnostic != nil && diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, diagnostic.Summary)
+			return
+		}
+	}
+}
+
+// isNull reports whether a state value is null
+func isNull(value *tfprotov6.DynamicValue) bool {
+	if value == nil {
+		return true
+	}
+	if value.MsgPack != nil {
+		return bytes.Equal(value.MsgPack, msgPackNull)
+	}
+	return value.JSON == nil || string(value.JSON) == "null"
+}
+
+func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	ctx, span := s.start(ctx, "provider", "Configure")
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
+
+func (s *server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "Read")
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
+
+func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "Plan")
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
+
+func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	operation := "Update"
+	switch {
+	case isNull(req.PriorState):
+		operation = "Create"
+	case isNull(req.PlannedState):
+		operation = "Delete"
+	}
+
+	ctx, span := s.start(ctx, req.TypeName, operation)
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
+
+func (s *server) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "Import")
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
+
+func (s *server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "Read")
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
+
+func (s *server) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "Open")
+	resp, err := s.ProviderServer.OpenEphemeralResource(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
+
+func (s *server) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "Close")
+	resp, err := s.ProviderServer.CloseEphemeralResource(ctx, req)
+	var diagnostics []*tfprotov6.Diagnostic
+	if resp != nil {
+		diagnostics = resp.Diagnostics
+	}
+	end(span, diagnostics, err)
+	return resp, err
+}
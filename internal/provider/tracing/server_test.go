@@ -0,0 +1,130 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// testServer is a provider server that records the span each call is made in
+type testServer struct {
+	tfprotov6.ProviderServer
+
+	spans       []trace.SpanContext
+	diagnostics []*tfprotov6.Diagnostic
+	err         error
+}
+
+func (s *testServer) ApplyResourceChange(ctx context.Context, _ *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	s.spans = append(s.spans, trace.SpanContextFromContext(ctx))
+	return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: s.diagnostics}, s.err
+}
+
+func (s *testServer) ReadDataSource(ctx context.Context, _ *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	s.spans = append(s.spans, trace.SpanContextFromContext(ctx))
+	return nil, s.err
+}
+
+// recordSpans installs a global tracer provider that records ended spans
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	restoreGlobals(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}
+
+func TestServerNamesApplyOperations(t *testing.T) {
+	clearEnv(t)
+	recorder := recordSpans(t)
+
+	value := &tfprotov6.DynamicValue{MsgPack: []byte{0x81, 0xa2, 0x69, 0x64, 0xa1, 0x78}}
+	null := &tfprotov6.DynamicValue{MsgPack: msgPackNull}
+	tests := map[string]*tfprotov6.ApplyResourceChangeRequest{
+		"lakefs_branch Create": {TypeName: "lakefs_branch", PriorState: null, PlannedState: value},
+		"lakefs_branch Update": {TypeName: "lakefs_branch", PriorState: value, PlannedState: value},
+		"lakefs_branch Delete": {TypeName: "lakefs_branch", PriorState: value, PlannedState: null},
+	}
+
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder.Reset()
+			inner := &testServer{}
+			server := WrapServer(func() tfprotov6.ProviderServer { return inner })()
+
+			if _, err := server.ApplyResourceChange(context.Background(), req); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 1 || spans[0].Name() != name {
+				t.Fatalf("expected a %s span, got: %v", name, spans)
+			}
+			if !inner.spans[0].Equal(spans[0].SpanContext()) {
+				t.Error("expected the operation to be called within its span")
+			}
+			if spans[0].Status().Code == codes.Error {
+				t.Errorf("unexpected error status: %v", spans[0].Status())
+			}
+		})
+	}
+}
+
+func TestServerRecordsErrors(t *testing.T) {
+	clearEnv(t)
+	recorder := recordSpans(t)
+
+	inner := &testServer{diagnostics: []*tfprotov6.Diagnostic{
+		{Severity: tfprotov6.DiagnosticSeverityWarning, Summary: "Deprecated"},
+		{Severity: tfprotov6.DiagnosticSeverityError, Summary: "Error creating branch"},
+	}}
+	server := WrapServer(func() tfprotov6.ProviderServer { return inner })()
+	_, _ = server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{TypeName: "lakefs_branch"})
+
+	inner = &testServer{err: errors.New("connection lost")}
+	server = WrapServer(func() tfprotov6.ProviderServer { return inner })()
+	_, _ = server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{TypeName: "lakefs_branch"})
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got: %v", spans)
+	}
+	if status := spans[0].Status(); status.Code != codes.Error || status.Description != "Error creating branch" {
+		t.Errorf("expected the error diagnostic in the status, got: %v", status)
+	}
+	if status := spans[1].Status(); status.Code != codes.Error || status.Description != "connection lost" {
+		t.Errorf("expected the error in the status, got: %v", status)
+	}
+	if spans[1].Name() != "lakefs_branch Read" {
+		t.Errorf("unexpected span name %s", spans[1].Name())
+	}
+}
+
+func TestServerContinuesEnvironmentTrace(t *testing.T) {
+	clearEnv(t)
+	recorder := recordSpans(t)
+	t.Setenv("TRACEPARENT", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	inner := &testServer{}
+	server := WrapServer(func() tfprotov6.ProviderServer { return inner })()
+	_, _ = server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{TypeName: "lakefs_repository"})
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got: %v", spans)
+	}
+	if spans[0].SpanContext().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the span to be part of the environment trace, got: %s", spans[0].SpanContext().TraceID())
+	}
+	if spans[0].Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("unexpected parent span %s", spans[0].Parent().SpanID())
+	}
+}
@@ -0,0 +1,238 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is a stand-in for an OpenTelemetry collector that receives spans
+// over OTLP/HTTP
+type collector struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	spans   []*tracepb.Span
+	service string
+}
+
+func newCollector(t *testing.T) *collector {
+	t.Helper()
+
+	c := &collector{}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("unexpected export path %s", r.URL.Path)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("unable to read export request: %s", err)
+		}
+		var req coltracepb.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &req); err != nil {
+			t.Errorf("unable to decode export request: %s", err)
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, resourceSpans := range req.ResourceSpans {
+			for _, attr := range resourceSpans.GetResource().GetAttributes() {
+				if attr.Key == "service.name" {
+					c.service = attr.GetValue().GetStringValue()
+				}
+			}
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				c.spans = append(c.spans, scopeSpans.Spans...)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		resp, _ := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+		_, _ = w.Write(resp)
+	}))
+	t.Cleanup(c.Close)
+	return c
+}
+
+// restoreGlobals restores the global tracer provider and propagator Setup
+// replaces once the test ends
+func restoreGlobals(t *testing.T) {
+	t.Helper()
+
+	provider := otel.GetTracerProvider()
+	propagator := otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+}
+
+// clearEnv unsets the environment variables that enable trace export
+func clearEnv(t *testing.T) {
+	t.Helper()
+
+	for _, name := range []string{
+		"OTEL_SDK_DISABLED",
+		"OTEL_TRACES_EXPORTER",
+		"OTEL_EXPORTER_OTLP_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_PROTOCOL",
+		"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL",
+		"OTEL_SERVICE_NAME",
+		"TRACEPARENT",
+		"TRACESTATE",
+	} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	tests := map[string]struct {
+		env      map[string]string
+		expected bool
+	}{
+		"unset": {
+			expected: false,
+		},
+		"endpoint": {
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			expected: true,
+		},
+		"traces endpoint": {
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"},
+			expected: true,
+		},
+		"otlp exporter": {
+			env:      map[string]string{"OTEL_TRACES_EXPORTER": "otlp"},
+			expected: true,
+		},
+		"none exporter": {
+			env:      map[string]string{"OTEL_TRACES_EXPORTER": "none", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			expected: false,
+		},
+		"sdk disabled": {
+			env:      map[string]string{"OTEL_SDK_DISABLED": "true", "OTEL_TRACES_EXPORTER": "otlp"},
+			expected: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			clearEnv(t)
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			if got := Enabled(); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetupExportsSpans(t *testing.T) {
+	clearEnv(t)
+	restoreGlobals(t)
+	collector := newCollector(t)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", collector.URL)
+
+	ctx := context.Background()
+	shutdown, err := Setup(ctx, "1.2.3")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, span := Tracer().Start(ctx, "lakefs_repository Create")
+	span.End()
+
+	if err := shutdown(ctx); err != nil {
+		t.Fatalf("unexpected shutdown error: %s", err)
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	if len(collector.spans) != 1 || collector.spans[0].Name != "lakefs_repository Create" {
+		t.Fatalf("expected the span to be exported, got: %v", collector.spans)
+	}
+	if collector.service != serviceName {
+		t.Errorf("expected service name %s, got %s", serviceName, collector.service)
+	}
+}
+
+func TestSetupPropagatesTraceContext(t *testing.T) {
+	clearEnv(t)
+	restoreGlobals(t)
+	collector := newCollector(t)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", collector.URL)
+
+	ctx := context.Background()
+	shutdown, err := Setup(ctx, "1.2.3")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer func() { _ = shutdown(ctx) }()
+
+	ctx, span := Tracer().Start(ctx, "test")
+	defer span.End()
+
+	header := http.Header{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+	if header.Get("traceparent") == "" {
+		t.Errorf("expected a traceparent header, got: %v", header)
+	}
+}
+
+func TestSetupDisabled(t *testing.T) {
+	clearEnv(t)
+	restoreGlobals(t)
+	provider := otel.GetTracerProvider()
+
+	shutdown, err := Setup(context.Background(), "1.2.3")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("unexpected shutdown error: %s", err)
+	}
+	if otel.GetTracerProvider() != provider {
+		t.Error("expected the global tracer provider to be kept")
+	}
+}
+
+func TestSetupRejectsUnsupportedProtocol(t *testing.T) {
+	clearEnv(t)
+	restoreGlobals(t)
+	t.Setenv("OTEL_TRACES_EXPORTER", "otlp")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/json")
+
+	if _, err := Setup(context.Background(), "1.2.3"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestEnvironmentParent(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("TRACEPARENT", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	parent := environmentParent()
+	if !parent.IsValid() || !parent.IsRemote() {
+		t.Fatalf("expected a valid remote span context, got: %v", parent)
+	}
+	if parent.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("unexpected trace ID %s", parent.TraceID())
+	}
+
+	t.Setenv("TRACEPARENT", "")
+	if environmentParent().IsValid() {
+		t.Error("expected no span context without TRACEPARENT")
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/naming"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &URIFunction{}
+
+// lakeFSURIScheme prefixes every LakeFS URI
+const lakeFSURIScheme = "lakefs://"
+
+func NewURIFunction() function.Function {
+	return &URIFunction{}
+}
+
+// URIFunction defines the function implementation.
+type URIFunction struct{}
+
+func (f *URIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uri"
+}
+
+func (f *URIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a LakeFS URI",
+		Description:         "Builds a lakefs://repository/ref/path URI after validating the repository name and ref. An empty path returns the URI of the ref.",
+		MarkdownDescription: "Builds a `lakefs://repository/ref/path` URI after validating the repository name and ref. An empty path returns the URI of the ref.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "repository",
+				Description: "The name of the repository",
+			},
+			function.StringParameter{
+				Name:        "ref",
+				Description: "A branch, tag, commit ID or ref expression",
+			},
+			function.StringParameter{
+				Name:        "path",
+				Description: "The object path within the ref",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *URIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var repository, ref, path string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &repository, &ref, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := naming.ValidateRepositoryName(repository); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if err := naming.ValidateRef(ref); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, formatURI(repository, ref, path)))
+}
+
+// formatURI builds a LakeFS URI. The path is used as is, since LakeFS object
+// paths are not escaped in URIs.
+func formatURI(repository, ref, path string) string {
+	uri := lakeFSURIScheme + repository + "/" + ref
+	if path != "" {
+		uri += "/" + path
+	}
+	return uri
+}
+
+// parseURI splits a LakeFS URI into its repository, ref and path. The ref and
+// path are empty if the URI does not include them.
+func parseURI(uri string) (repository, ref, path string, err error) {
+	rest, ok := strings.CutPrefix(uri, lakeFSURIScheme)
+	if !ok {
+		return "", "", "", fmt.Errorf("invalid LakeFS URI %q: must start with %s", uri, lakeFSURIScheme)
+	}
+
+	parts := strings.SplitN(rest, "/", 3)
+	repository = parts[0]
+	if err := naming.ValidateRepositoryName(repository); err != nil {
+		return "", "", "", fmt.Errorf("invalid LakeFS URI %q: %w", uri, err)
+	}
+
+	if len(parts) > 1 {
+		ref = parts[1]
+		if err := naming.ValidateRef(ref); err != nil {
+			return "", "", "", fmt.Errorf("invalid LakeFS URI %q: %w", uri, err)
+		}
+	}
+	if len(parts) > 2 {
+		path = parts[2]
+	}
+
+	return repository, ref, path, nil
+}
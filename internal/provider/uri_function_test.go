@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		uri                   string
+		repository, ref, path string
+	}{
+		{"lakefs://repo", "repo", "", ""},
+		{"lakefs://repo/main", "repo", "main", ""},
+		{"lakefs://repo/main~1/data/file.parquet", "repo", "main~1", "data/file.parquet"},
+		{"lakefs://repo/main/", "repo", "main", ""},
+	}
+
+	for _, test := range tests {
+		repository, ref, path, err := parseURI(test.uri)
+		if err != nil {
+			t.Errorf("parseURI(%q): unexpected error: %s", test.uri, err)
+			continue
+		}
+		if repository != test.repository || ref != test.ref || path != test.path {
+			t.Errorf("parseURI(%q) = %q, %q, %q", test.uri, repository, ref, path)
+		}
+	}
+
+	for _, uri := range []string{"s3://repo/main", "lakefs://Repo/main", "lakefs://repo//path"} {
+		if _, _, _, err := parseURI(uri); err == nil {
+			t.Errorf("expected parseURI(%q) to fail", uri)
+		}
+	}
+}
+
+func TestFormatURIRoundTrip(t *testing.T) {
+	uri := formatURI("repo", "main", "data/file.parquet")
+	if uri != "lakefs://repo/main/data/file.parquet" {
+		t.Errorf("unexpected URI: %s", uri)
+	}
+	if uri := formatURI("repo", "main", ""); uri != "lakefs://repo/main" {
+		t.Errorf("unexpected URI: %s", uri)
+	}
+
+	repository, ref, path, err := parseURI(uri)
+	if err != nil || repository != "repo" || ref != "main" || path != "data/file.parquet" {
+		t.Errorf("unexpected round trip: %q, %q, %q, %v", repository, ref, path, err)
+	}
+}
+
+func TestAccProviderFunctions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "uri" {
+  value = provider::lakefs::uri("my-repo", "main", "data/file.parquet")
+}
+
+output "parsed_ref" {
+  value = provider::lakefs::parse_uri("lakefs://my-repo/main~1/data").ref
+}
+
+output "repository_arn" {
+  value = provider::lakefs::repository_arn("my-repo")
+}
+
+output "object_arn" {
+  value = provider::lakefs::object_arn("my-repo", "data/*")
+}
+
+output "branch_arn" {
+  value = provider::lakefs::branch_arn("*", "main")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("uri", "lakefs://my-repo/main/data/file.parquet"),
+					resource.TestCheckOutput("parsed_ref", "main~1"),
+					resource.TestCheckOutput("repository_arn", "arn:lakefs:fs:::repository/my-repo"),
+					resource.TestCheckOutput("object_arn", "arn:lakefs:fs:::repository/my-repo/object/data/*"),
+					resource.TestCheckOutput("branch_arn", "arn:lakefs:fs:::repository/*/branch/main"),
+				),
+			},
+			{
+				Config: `
+output "uri" {
+  value = provider::lakefs::uri("My_Repo", "main", "")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid repository name`),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_user_credentials_list"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserCredentialsListDataSource{}
+
+func NewUserCredentialsListDataSource() datasource.DataSource {
+	return &UserCredentialsListDataSource{}
+}
+
+// UserCredentialsListDataSource defines the data source implementation.
+type UserCredentialsListDataSource struct {
+	dataSourceBase
+}
+
+func (d *UserCredentialsListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_credentials_list"
+}
+
+func (d *UserCredentialsListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_user_credentials_list.UserCredentialsListDataSourceSchema(ctx)
+}
+
+func (d *UserCredentialsListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_user_credentials_list.UserCredentialsListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	userID := data.UserId.ValueString()
+	results, err := client.listUserCredentials(ctx, userID, lakefsapi.ListUserCredentialsParams{})
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("list credentials of user %s", userID), err)
+		return
+	}
+
+	data.Id = types.StringValue(userID)
+	data.Credentials = make([]datasource_user_credentials_list.CredentialModel, 0, len(results))
+	for _, creds := range results {
+		data.Credentials = append(data.Credentials, datasource_user_credentials_list.CredentialModel{
+			AccessKeyId:  types.StringValue(creds.AccessKeyID),
+			CreationDate: types.Int64Value(creds.CreationDate),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
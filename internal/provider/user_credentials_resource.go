@@ -3,10 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_user_credentials"
 )
@@ -14,6 +19,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserCredentialsResource{}
 var _ resource.ResourceWithImportState = &UserCredentialsResource{}
+var _ resource.ResourceWithModifyPlan = &UserCredentialsResource{}
+var _ resource.ResourceWithValidateConfig = &UserCredentialsResource{}
 
 func NewUserCredentialsResource() resource.Resource {
 	return &UserCredentialsResource{}
@@ -21,14 +28,7 @@ func NewUserCredentialsResource() resource.Resource {
 
 // UserCredentialsResource defines the resource implementation.
 type UserCredentialsResource struct {
-	client *LakeFSClient
-}
-
-// CredentialsResponse represents the API response for credentials
-type CredentialsResponse struct {
-	AccessKeyID     string `json:"access_key_id"`
-	SecretAccessKey string `json:"secret_access_key,omitempty"`
-	CreationDate    int64  `json:"creation_date"`
+	resourceBase
 }
 
 func (r *UserCredentialsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,21 +39,111 @@ func (r *UserCredentialsResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = resource_user_credentials.UserCredentialsResourceSchema(ctx)
 }
 
-func (r *UserCredentialsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+func (r *UserCredentialsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_user_credentials.UserCredentialsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	if !data.PgpKey.IsNull() && !data.PgpKey.IsUnknown() {
+		if _, err := readPGPPublicKey(data.PgpKey.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pgp_key"),
+				"Invalid PGP Key",
+				fmt.Sprintf("Unable to use the PGP key: %s", err),
+			)
+		}
+	}
+
+	rotation, diags := userCredentialsRotation(ctx, data.Rotation)
+	resp.Diagnostics.Append(diags...)
+	if rotation == nil {
+		return
+	}
+
+	for name, value := range map[string]types.String{
+		"rotate_after": rotation.RotateAfter,
+		"overlap":      rotation.Overlap,
+	} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.ParseDuration(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rotation").AtName(name),
+				"Invalid Duration",
+				fmt.Sprintf("Expected a duration such as \"720h\" or \"30m\", got %q: %s", value.ValueString(), err),
+			)
+		}
+	}
+}
+
+// ModifyPlan decides whether an apply rotates the key pair or retires the
+// previous key pair. Both are in-place updates, so the user is never without
+// a valid key.
+func (r *UserCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to decide on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resource_user_credentials.UserCredentialsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planRotation, diags := userCredentialsRotation(ctx, plan.Rotation)
+	resp.Diagnostics.Append(diags...)
+	stateRotation, diags := userCredentialsRotation(ctx, state.Rotation)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.client = client
+	// Carry the current key pair over unless a rotation is due
+	plan.AccessKeyId = state.AccessKeyId
+	plan.SecretAccessKey = state.SecretAccessKey
+	plan.KeyFingerprint = state.KeyFingerprint
+	plan.EncryptedSecret = state.EncryptedSecret
+	plan.CreationDate = state.CreationDate
+	plan.RotatedAt = state.RotatedAt
+	plan.PreviousAccessKeyId = state.PreviousAccessKeyId
+	plan.PreviousSecretAccessKey = state.PreviousSecretAccessKey
+	plan.PreviousEncryptedSecret = state.PreviousEncryptedSecret
+
+	now := time.Now()
+
+	if reason := userCredentialsRotationReason(planRotation, stateRotation, state.RotatedAt, now); reason != "" {
+		tflog.Debug(ctx, "Planning credentials rotation", map[string]any{
+			"user_id":       state.UserId.ValueString(),
+			"access_key_id": state.AccessKeyId.ValueString(),
+			"reason":        reason,
+		})
+
+		plan.AccessKeyId = types.StringUnknown()
+		plan.SecretAccessKey = types.StringUnknown()
+		plan.EncryptedSecret = types.StringUnknown()
+		plan.CreationDate = types.Int64Unknown()
+		plan.RotatedAt = types.Int64Unknown()
+		plan.PreviousAccessKeyId = types.StringUnknown()
+		plan.PreviousSecretAccessKey = types.StringUnknown()
+		plan.PreviousEncryptedSecret = types.StringUnknown()
+	} else if !state.PreviousAccessKeyId.IsNull() && userCredentialsOverlapElapsed(planRotation, state.RotatedAt, now) {
+		tflog.Debug(ctx, "Planning deletion of previous credentials", map[string]any{
+			"user_id":       state.UserId.ValueString(),
+			"access_key_id": state.PreviousAccessKeyId.ValueString(),
+		})
+
+		plan.PreviousAccessKeyId = types.StringNull()
+		plan.PreviousSecretAccessKey = types.StringNull()
+		plan.PreviousEncryptedSecret = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *UserCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -64,19 +154,38 @@ func (r *UserCredentialsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	userID := data.UserId.ValueString()
 
-	var result CredentialsResponse
-	err := client.Post(ctx, fmt.Sprintf("/auth/users/%s/credentials", userID), nil, &result)
+	result, err := client.CreateCredentialsWithResponse(ctx, userID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create credentials for user %s: %s", userID, err))
+		addClientError(&resp.Diagnostics, fmt.Sprintf("create credentials for user %s", userID), err)
 		return
 	}
 
-	data.AccessKeyId = types.StringValue(result.AccessKeyID)
-	data.SecretAccessKey = types.StringValue(result.SecretAccessKey)
-	data.CreationDate = types.Int64Value(result.CreationDate)
+	data.AccessKeyId = types.StringValue(result.JSON201.AccessKeyID)
+	data.CreationDate = types.Int64Value(result.JSON201.CreationDate)
+	data.RotatedAt = types.Int64Value(result.JSON201.CreationDate)
+	data.PreviousAccessKeyId = types.StringNull()
+	data.PreviousSecretAccessKey = types.StringNull()
+	data.PreviousEncryptedSecret = types.StringNull()
+
+	if err := setUserCredentialsSecret(&data, result.JSON201.SecretAccessKey); err != nil {
+		resp.Diagnostics.AddError("Encryption Error", fmt.Sprintf("Unable to encrypt the secret access key, the credentials %s were deleted: %s", result.JSON201.AccessKeyID, err))
+		if err := deleteUserCredentials(ctx, client, userID, result.JSON201.AccessKeyID); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("delete credentials %s for user %s", result.JSON201.AccessKeyID, userID), err)
+		}
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -89,30 +198,138 @@ func (r *UserCredentialsResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	userID := data.UserId.ValueString()
 	accessKeyID := data.AccessKeyId.ValueString()
 
-	var result CredentialsResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/users/%s/credentials/%s", userID, accessKeyID), &result)
+	result, err := client.GetCredentialsWithResponse(ctx, userID, accessKeyID)
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credentials: %s", err))
+		readFailed(ctx, resp, "read credentials", err)
 		return
 	}
 
-	data.AccessKeyId = types.StringValue(result.AccessKeyID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
+	data.AccessKeyId = types.StringValue(result.JSON200.AccessKeyID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
 	// SecretAccessKey is not returned by the API on GET, so we keep the one from state
+	if data.RotatedAt.IsNull() {
+		data.RotatedAt = types.Int64Value(result.JSON200.CreationDate)
+	}
+
+	if !data.PreviousAccessKeyId.IsNull() {
+		previousAccessKeyID := data.PreviousAccessKeyId.ValueString()
+		_, err := client.GetCredentialsWithResponse(ctx, userID, previousAccessKeyID)
+		if err != nil {
+			if !IsNotFound(err) {
+				addClientError(&resp.Diagnostics, "read previous credentials", err)
+				return
+			}
+			// Deleted outside of Terraform, nothing left to retire
+			data.PreviousAccessKeyId = types.StringNull()
+			data.PreviousSecretAccessKey = types.StringNull()
+			data.PreviousEncryptedSecret = types.StringNull()
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UserCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// No-op as all fields require replacement
+	var data, state resource_user_credentials.UserCredentialsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client := NewAPIClient(r.client)
+	userID := data.UserId.ValueString()
+
+	// ModifyPlan marks the key pair unknown when a rotation is due
+	if data.AccessKeyId.IsUnknown() {
+		// Any older key still in its overlap is retired now, only one previous key is kept
+		if !state.PreviousAccessKeyId.IsNull() {
+			if err := deleteUserCredentials(ctx, client, userID, state.PreviousAccessKeyId.ValueString()); err != nil {
+				addClientError(&resp.Diagnostics, fmt.Sprintf("delete previous credentials %s for user %s", state.PreviousAccessKeyId.ValueString(), userID), err)
+				return
+			}
+		}
+
+		result, err := client.CreateCredentialsWithResponse(ctx, userID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("create credentials for user %s", userID), err)
+			return
+		}
+
+		data.PreviousAccessKeyId = state.AccessKeyId
+		data.PreviousSecretAccessKey = state.SecretAccessKey
+		data.PreviousEncryptedSecret = state.EncryptedSecret
+		data.AccessKeyId = types.StringValue(result.JSON201.AccessKeyID)
+		data.CreationDate = types.Int64Value(result.JSON201.CreationDate)
+		data.RotatedAt = types.Int64Value(time.Now().Unix())
+
+		if err := setUserCredentialsSecret(&data, result.JSON201.SecretAccessKey); err != nil {
+			resp.Diagnostics.AddError("Encryption Error", fmt.Sprintf("Unable to encrypt the secret access key, the credentials %s were deleted: %s", result.JSON201.AccessKeyID, err))
+			if err := deleteUserCredentials(ctx, client, userID, result.JSON201.AccessKeyID); err != nil {
+				addClientError(&resp.Diagnostics, fmt.Sprintf("delete credentials %s for user %s", result.JSON201.AccessKeyID, userID), err)
+			}
+			return
+		}
+
+		tflog.Info(ctx, "Rotated credentials", map[string]any{
+			"user_id":                userID,
+			"access_key_id":          result.JSON201.AccessKeyID,
+			"previous_access_key_id": state.AccessKeyId.ValueString(),
+		})
+
+		// Without an overlap the previous key is not kept at all
+		rotation, diags := userCredentialsRotation(ctx, data.Rotation)
+		resp.Diagnostics.Append(diags...)
+		if overlap, ok := userCredentialsDuration(rotationOverlap(rotation)); ok && overlap == 0 {
+			data.PreviousAccessKeyId = types.StringNull()
+			data.PreviousSecretAccessKey = types.StringNull()
+			data.PreviousEncryptedSecret = types.StringNull()
+			if err := deleteUserCredentials(ctx, client, userID, state.AccessKeyId.ValueString()); err != nil {
+				addClientError(&resp.Diagnostics, fmt.Sprintf("delete previous credentials %s for user %s", state.AccessKeyId.ValueString(), userID), err)
+			}
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if data.PreviousAccessKeyId.IsNull() && !state.PreviousAccessKeyId.IsNull() {
+		previousAccessKeyID := state.PreviousAccessKeyId.ValueString()
+		if err := deleteUserCredentials(ctx, client, userID, previousAccessKeyID); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("delete previous credentials %s for user %s", previousAccessKeyID, userID), err)
+			return
+		}
+
+		tflog.Info(ctx, "Deleted previous credentials", map[string]any{
+			"user_id":       userID,
+			"access_key_id": previousAccessKeyID,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UserCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -123,17 +340,155 @@ func (r *UserCredentialsResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	userID := data.UserId.ValueString()
 	accessKeyID := data.AccessKeyId.ValueString()
 
-	err := client.Delete(ctx, fmt.Sprintf("/auth/users/%s/credentials/%s", userID, accessKeyID))
+	if !data.PreviousAccessKeyId.IsNull() {
+		previousAccessKeyID := data.PreviousAccessKeyId.ValueString()
+		if err := deleteUserCredentials(ctx, client, userID, previousAccessKeyID); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("delete previous credentials %s for user %s", previousAccessKeyID, userID), err)
+			return
+		}
+	}
+
+	err := deleteUserCredentials(ctx, client, userID, accessKeyID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete credentials %s for user %s: %s", accessKeyID, userID, err))
+		addClientError(&resp.Diagnostics, fmt.Sprintf("delete credentials %s for user %s", accessKeyID, userID), err)
 		return
 	}
 }
 
 func (r *UserCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+	// Import ID format: user_id/access_key_id
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'user_id/access_key_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	// The secret access key cannot be recovered, Read fills in the remaining attributes
+	var data resource_user_credentials.UserCredentialsModel
+	data.UserId = types.StringValue(parts[0])
+	data.AccessKeyId = types.StringValue(parts[1])
+	data.SecretAccessKey = types.StringNull()
+	data.PgpKey = types.StringNull()
+	data.KeyFingerprint = types.StringNull()
+	data.EncryptedSecret = types.StringNull()
+	data.CreationDate = types.Int64Null()
+	data.Rotation = types.ObjectNull(resource_user_credentials.RotationAttrTypes())
+	data.RotatedAt = types.Int64Null()
+	data.PreviousAccessKeyId = types.StringNull()
+	data.PreviousSecretAccessKey = types.StringNull()
+	data.PreviousEncryptedSecret = types.StringNull()
+	data.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setUserCredentialsSecret stores a newly minted secret, encrypted when a PGP
+// key is configured so that the clear secret never reaches the state
+func setUserCredentialsSecret(data *resource_user_credentials.UserCredentialsModel, secret string) error {
+	if data.PgpKey.IsNull() {
+		data.SecretAccessKey = types.StringValue(secret)
+		data.EncryptedSecret = types.StringNull()
+		data.KeyFingerprint = types.StringNull()
+		return nil
+	}
+
+	encrypted, fingerprint, err := encryptWithPGPKey(data.PgpKey.ValueString(), secret)
+	if err != nil {
+		return err
+	}
+
+	data.SecretAccessKey = types.StringNull()
+	data.EncryptedSecret = types.StringValue(encrypted)
+	data.KeyFingerprint = types.StringValue(fingerprint)
+	return nil
+}
+
+// deleteUserCredentials deletes a key pair, treating an already deleted key as success
+func deleteUserCredentials(ctx context.Context, client *APIClient, userID, accessKeyID string) error {
+	_, err := client.DeleteCredentialsWithResponse(ctx, userID, accessKeyID)
+	return IgnoreNotFound(err)
+}
+
+// userCredentialsRotation converts the rotation object, returning nil when it is not set
+func userCredentialsRotation(ctx context.Context, obj types.Object) (*resource_user_credentials.RotationModel, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var rotation resource_user_credentials.RotationModel
+	diags := obj.As(ctx, &rotation, basetypes.ObjectAsOptions{})
+	return &rotation, diags
+}
+
+// userCredentialsRotationReason returns why the key pair is due for rotation,
+// or an empty string when it is not
+func userCredentialsRotationReason(plan, state *resource_user_credentials.RotationModel, rotatedAt types.Int64, now time.Time) string {
+	if plan == nil {
+		return ""
+	}
+
+	if !plan.Keepers.IsNull() {
+		if plan.Keepers.IsUnknown() {
+			return "keepers are unknown"
+		}
+		if state != nil && !state.Keepers.IsNull() && !plan.Keepers.Equal(state.Keepers) {
+			return "keepers changed"
+		}
+	}
+
+	if rotateAfter, ok := userCredentialsDuration(plan.RotateAfter); ok && !rotatedAt.IsNull() {
+		if !now.Before(time.Unix(rotatedAt.ValueInt64(), 0).Add(rotateAfter)) {
+			return "rotate_after elapsed"
+		}
+	}
+
+	return ""
+}
+
+// userCredentialsOverlapElapsed reports whether the previous key pair has
+// outlived its overlap. Without a rotation block there is no overlap.
+func userCredentialsOverlapElapsed(rotation *resource_user_credentials.RotationModel, rotatedAt types.Int64, now time.Time) bool {
+	if rotation == nil || rotatedAt.IsNull() {
+		return true
+	}
+	overlap, ok := userCredentialsDuration(rotation.Overlap)
+	if !ok {
+		// Wait until the overlap is known
+		return false
+	}
+	return !now.Before(time.Unix(rotatedAt.ValueInt64(), 0).Add(overlap))
+}
+
+func rotationOverlap(rotation *resource_user_credentials.RotationModel) types.String {
+	if rotation == nil {
+		return types.StringNull()
+	}
+	return rotation.Overlap
+}
+
+func userCredentialsDuration(value types.String) (time.Duration, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, false
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, false
+	}
+	return d, true
 }
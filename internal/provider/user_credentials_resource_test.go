@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_user_credentials"
+)
+
+func TestUserCredentialsRotationReason(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	keepers := func(v string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(v)})
+	}
+
+	cases := map[string]struct {
+		plan      *resource_user_credentials.RotationModel
+		state     *resource_user_credentials.RotationModel
+		rotatedAt types.Int64
+		rotate    bool
+	}{
+		"no rotation block": {
+			rotatedAt: types.Int64Value(0),
+		},
+		"keepers unchanged": {
+			plan:      &resource_user_credentials.RotationModel{Keepers: keepers("1"), RotateAfter: types.StringNull()},
+			state:     &resource_user_credentials.RotationModel{Keepers: keepers("1"), RotateAfter: types.StringNull()},
+			rotatedAt: types.Int64Value(now.Unix()),
+		},
+		"keepers changed": {
+			plan:      &resource_user_credentials.RotationModel{Keepers: keepers("2"), RotateAfter: types.StringNull()},
+			state:     &resource_user_credentials.RotationModel{Keepers: keepers("1"), RotateAfter: types.StringNull()},
+			rotatedAt: types.Int64Value(now.Unix()),
+			rotate:    true,
+		},
+		"keepers added": {
+			plan:      &resource_user_credentials.RotationModel{Keepers: keepers("1"), RotateAfter: types.StringNull()},
+			rotatedAt: types.Int64Value(now.Unix()),
+		},
+		"rotate_after not elapsed": {
+			plan:      &resource_user_credentials.RotationModel{Keepers: types.MapNull(types.StringType), RotateAfter: types.StringValue("24h")},
+			rotatedAt: types.Int64Value(now.Add(-23 * time.Hour).Unix()),
+		},
+		"rotate_after elapsed": {
+			plan:      &resource_user_credentials.RotationModel{Keepers: types.MapNull(types.StringType), RotateAfter: types.StringValue("24h")},
+			rotatedAt: types.Int64Value(now.Add(-24 * time.Hour).Unix()),
+			rotate:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reason := userCredentialsRotationReason(tc.plan, tc.state, tc.rotatedAt, now)
+			if (reason != "") != tc.rotate {
+				t.Errorf("expected rotation %t, got reason %q", tc.rotate, reason)
+			}
+		})
+	}
+}
+
+func TestUserCredentialsOverlapElapsed(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	rotation := &resource_user_credentials.RotationModel{Overlap: types.StringValue("1h")}
+
+	if userCredentialsOverlapElapsed(rotation, types.Int64Value(now.Add(-30*time.Minute).Unix()), now) {
+		t.Error("expected overlap not to have elapsed")
+	}
+	if !userCredentialsOverlapElapsed(rotation, types.Int64Value(now.Add(-time.Hour).Unix()), now) {
+		t.Error("expected overlap to have elapsed")
+	}
+	if !userCredentialsOverlapElapsed(nil, types.Int64Value(now.Unix()), now) {
+		t.Error("expected no overlap without a rotation block")
+	}
+}
+
+func TestUserCredentialsReadClearsDeletedPreviousKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/credentials/AKIAPREVIOUS") {
+			testWriteJSON(w, http.StatusNotFound, json.RawMessage(`{"message":"credentials not found"}`))
+			return
+		}
+		testWriteJSON(w, http.StatusOK, json.RawMessage(`{"access_key_id":"AKIACURRENT","creation_date":1700000000}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	schema := resource_user_credentials.UserCredentialsResourceSchema(ctx)
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+	data := resource_user_credentials.UserCredentialsModel{
+		UserId:                  types.StringValue("jane"),
+		AccessKeyId:             types.StringValue("AKIACURRENT"),
+		SecretAccessKey:         types.StringNull(),
+		PgpKey:                  types.StringValue("key"),
+		KeyFingerprint:          types.StringValue("fingerprint"),
+		EncryptedSecret:         types.StringValue("current-encrypted"),
+		CreationDate:            types.Int64Value(1700000000),
+		Rotation:                types.ObjectNull(resource_user_credentials.RotationAttrTypes()),
+		RotatedAt:               types.Int64Value(1700000000),
+		PreviousAccessKeyId:     types.StringValue("AKIAPREVIOUS"),
+		PreviousSecretAccessKey: types.StringNull(),
+		PreviousEncryptedSecret: types.StringValue("previous-encrypted"),
+		Timeouts:                nullTimeouts(state),
+	}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error setting state: %v", diags)
+	}
+
+	r := &UserCredentialsResource{resourceBase{client: &LakeFSClient{Endpoint: server.URL}}}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got resource_user_credentials.UserCredentialsModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if !got.PreviousAccessKeyId.IsNull() || !got.PreviousSecretAccessKey.IsNull() || !got.PreviousEncryptedSecret.IsNull() {
+		t.Errorf("expected the previous key to be cleared, got %s, %s and %s", got.PreviousAccessKeyId, got.PreviousSecretAccessKey, got.PreviousEncryptedSecret)
+	}
+	if got.EncryptedSecret.ValueString() != "current-encrypted" {
+		t.Errorf("expected the current secret to be kept, got %s", got.EncryptedSecret)
+	}
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,7 +18,7 @@ func NewUserDataSource() datasource.DataSource {
 
 // UserDataSource defines the data source implementation.
 type UserDataSource struct {
-	client *LakeFSClient
+	dataSourceBase
 }
 
 func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -30,23 +29,6 @@ func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 	resp.Schema = datasource_user.UserDataSourceSchema(ctx)
 }
 
-func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
-}
-
 func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data datasource_user.UserModel
 
@@ -57,20 +39,16 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	client := NewAPIClient(d.client)
 
-	var result UserResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/users/%s", data.Id.ValueString()), &result)
+	result, err := client.GetUserWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		addClientError(&resp.Diagnostics, "read user", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	if result.FriendlyName != "" {
-		data.FriendlyName = types.StringValue(result.FriendlyName)
-	} else {
-		data.FriendlyName = types.StringNull()
-	}
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	data.Email = optionalStringValue(result.JSON200.Email)
+	data.FriendlyName = optionalStringValue(result.JSON200.FriendlyName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
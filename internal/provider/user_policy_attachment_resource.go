@@ -7,12 +7,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_user_policy_attachment"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserPolicyAttachmentResource{}
 var _ resource.ResourceWithImportState = &UserPolicyAttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &UserPolicyAttachmentResource{}
 
 func NewUserPolicyAttachmentResource() resource.Resource {
 	return &UserPolicyAttachmentResource{}
@@ -20,7 +22,7 @@ func NewUserPolicyAttachmentResource() resource.Resource {
 
 // UserPolicyAttachmentResource defines the resource implementation.
 type UserPolicyAttachmentResource struct {
-	client *LakeFSClient
+	resourceBase
 }
 
 func (r *UserPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -31,21 +33,14 @@ func (r *UserPolicyAttachmentResource) Schema(ctx context.Context, req resource.
 	resp.Schema = resource_user_policy_attachment.UserPolicyAttachmentResourceSchema(ctx)
 }
 
-func (r *UserPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ModifyPlan reports servers without RBAC, which cannot attach policies
+func (r *UserPolicyAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
+	resp.Diagnostics.Append(checkServerRequirement(ctx, r.client, "lakefs_user_policy_attachment", serverRequirement{RBAC: true})...)
 }
 
 func (r *UserPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -56,13 +51,22 @@ func (r *UserPolicyAttachmentResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	userID := data.UserId.ValueString()
 	policyID := data.PolicyId.ValueString()
 
-	err := client.Put(ctx, fmt.Sprintf("/auth/users/%s/policies/%s", userID, policyID), nil, nil)
+	_, err := client.AttachPolicyToUserWithResponse(ctx, userID, policyID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach policy %s to user %s: %s", policyID, userID, err))
+		addClientError(&resp.Diagnostics, fmt.Sprintf("attach policy %s to user %s", policyID, userID), err)
 		return
 	}
 
@@ -77,29 +81,29 @@ func (r *UserPolicyAttachmentResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	userID := data.UserId.ValueString()
 	policyID := data.PolicyId.ValueString()
 
 	// List all policies attached to the user and check if the policy is attached
-	var result struct {
-		Results []struct {
-			ID string `json:"id"`
-		} `json:"results"`
-	}
-	err := client.Get(ctx, fmt.Sprintf("/auth/users/%s/policies", userID), &result)
+	policies, err := client.listUserPolicies(ctx, userID, lakefsapi.ListUserPoliciesParams{})
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user policy attachment: %s", err))
+		readFailed(ctx, resp, "read user policy attachment", err)
 		return
 	}
 
 	// Check if the policy is in the list
 	found := false
-	for _, policy := range result.Results {
+	for _, policy := range policies {
 		if policy.ID == policyID {
 			found = true
 			break
@@ -126,13 +130,22 @@ func (r *UserPolicyAttachmentResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 	userID := data.UserId.ValueString()
 	policyID := data.PolicyId.ValueString()
 
-	err := client.Delete(ctx, fmt.Sprintf("/auth/users/%s/policies/%s", userID, policyID))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach policy %s from user %s: %s", policyID, userID, err))
+	_, err := client.DetachPolicyFromUserWithResponse(ctx, userID, policyID)
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("detach policy %s from user %s", policyID, userID), err)
 		return
 	}
 }
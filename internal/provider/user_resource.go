@@ -2,18 +2,20 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/resource_user"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -21,19 +23,7 @@ func NewUserResource() resource.Resource {
 
 // UserResource defines the resource implementation.
 type UserResource struct {
-	client *LakeFSClient
-}
-
-// UserCreateRequest represents the request to create a user
-type UserCreateRequest struct {
-	ID string `json:"id"`
-}
-
-// UserResponse represents the API response for a user
-type UserResponse struct {
-	ID           string `json:"id"`
-	CreationDate int64  `json:"creation_date"`
-	FriendlyName string `json:"friendly_name,omitempty"`
+	resourceBase
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,21 +34,22 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = resource_user.UserResourceSchema(ctx)
 }
 
-func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ValidateConfig requires an email address to send the invitation to
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_user.UserModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+	if data.InviteUser.ValueBool() && data.Email.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Missing Email",
+			"The email attribute is required when invite_user is true, since the invitation is sent to the user's email address.",
 		)
-		return
 	}
-
-	r.client = client
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -69,27 +60,53 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	createReq := UserCreateRequest{
-		ID: data.Id.ValueString(),
+	createReq := lakefsapi.UserCreation{
+		ID:         data.Id.ValueString(),
+		InviteUser: data.InviteUser.ValueBool(),
 	}
 
-	var result UserResponse
-	err := client.Post(ctx, "/auth/users", createReq, &result)
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
+		createReq.Email = data.Email.ValueString()
+	}
+
+	if !data.FriendlyName.IsNull() && !data.FriendlyName.IsUnknown() {
+		createReq.FriendlyName = data.FriendlyName.ValueString()
+	}
+
+	tflog.Debug(ctx, "Creating user", map[string]any{
+		"id":          createReq.ID,
+		"invite_user": createReq.InviteUser,
+	})
+
+	result, err := client.CreateUserWithResponse(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user: %s", err))
+		addClientError(&resp.Diagnostics, "create user", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	if result.FriendlyName != "" {
-		data.FriendlyName = types.StringValue(result.FriendlyName)
-	} else {
-		data.FriendlyName = types.StringNull()
+	data.Id = types.StringValue(result.JSON201.ID)
+	data.CreationDate = types.Int64Value(result.JSON201.CreationDate)
+	// Servers that do not echo optional fields on creation keep the configured values
+	if result.JSON201.Email != "" || data.Email.IsUnknown() {
+		data.Email = optionalStringValue(result.JSON201.Email)
+	}
+	if result.JSON201.FriendlyName != "" || data.FriendlyName.IsUnknown() {
+		data.FriendlyName = optionalStringValue(result.JSON201.FriendlyName)
 	}
 
+	tflog.Trace(ctx, "Created user", map[string]any{"id": result.JSON201.ID})
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -101,31 +118,51 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	var result UserResponse
-	err := client.Get(ctx, fmt.Sprintf("/auth/users/%s", data.Id.ValueString()), &result)
+	result, err := client.GetUserWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
-		if IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		readFailed(ctx, resp, "read user", err)
 		return
 	}
 
-	data.Id = types.StringValue(result.ID)
-	data.CreationDate = types.Int64Value(result.CreationDate)
-	if result.FriendlyName != "" {
-		data.FriendlyName = types.StringValue(result.FriendlyName)
-	} else {
-		data.FriendlyName = types.StringNull()
+	data.Id = types.StringValue(result.JSON200.ID)
+	data.CreationDate = types.Int64Value(result.JSON200.CreationDate)
+	data.Email = optionalStringValue(result.JSON200.Email)
+	data.FriendlyName = optionalStringValue(result.JSON200.FriendlyName)
+	if data.InviteUser.IsNull() {
+		// Not returned by the API, e.g. after import
+		data.InviteUser = types.BoolValue(false)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan resource_user.UserModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API has no user update endpoint, and changes of the user's
+	// attributes force replacement. Only invite_user, which is used on
+	// creation, and the timeouts change in place, so there is nothing to send.
+	data.InviteUser = plan.InviteUser
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -136,11 +173,20 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client := NewAPIClient(r.client)
 
-	err := client.Delete(ctx, fmt.Sprintf("/auth/users/%s", data.Id.ValueString()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user: %s", err))
+	_, err := client.DeleteUserWithResponse(ctx, data.Id.ValueString())
+	if err := IgnoreNotFound(err); err != nil {
+		addClientError(&resp.Diagnostics, "delete user", err)
 		return
 	}
 }
@@ -148,3 +194,11 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// optionalStringValue maps an empty API string to a null Terraform value
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserInviteUserUpdatesInPlace(t *testing.T) {
+	computed := map[string]tftypes.Value{
+		"creation_date": tftypes.NewValue(tftypes.Number, big.NewFloat(1700000000)),
+		"friendly_name": tftypes.NewValue(tftypes.String, "Jane"),
+	}
+
+	for _, invite := range []bool{true, false} {
+		oldConfig := map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "jane"),
+			"email":       tftypes.NewValue(tftypes.String, "jane@example.com"),
+			"invite_user": tftypes.NewValue(tftypes.Bool, !invite),
+		}
+		newConfig := map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "jane"),
+			"email":       tftypes.NewValue(tftypes.String, "jane@example.com"),
+			"invite_user": tftypes.NewValue(tftypes.Bool, invite),
+		}
+
+		testUpdateInPlace(t, "lakefs_user", oldConfig, newConfig, computed)
+	}
+}
+
+func TestUserInviteUserRequiresEmail(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]tftypes.Value
+		errors int
+	}{
+		"invite without email": {
+			config: map[string]tftypes.Value{"invite_user": tftypes.NewValue(tftypes.Bool, true)},
+			errors: 1,
+		},
+		"invite with email": {
+			config: map[string]tftypes.Value{
+				"invite_user": tftypes.NewValue(tftypes.Bool, true),
+				"email":       tftypes.NewValue(tftypes.String, "jane@example.com"),
+			},
+		},
+		"invite with unknown email": {
+			config: map[string]tftypes.Value{
+				"invite_user": tftypes.NewValue(tftypes.Bool, true),
+				"email":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		"no invite": {
+			config: map[string]tftypes.Value{"invite_user": tftypes.NewValue(tftypes.Bool, false)},
+		},
+	}
+
+	server := providerserver.NewProtocol6(New("test")())()
+	typ := testResourceType(t, server, "lakefs_user")
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := testObject(typ, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "jane")}, test.config)
+			resp, err := server.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: "lakefs_user",
+				Config:   testDynamicValue(t, typ, config),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(resp.Diagnostics) != test.errors {
+				t.Fatalf("expected %d errors, got: %v", test.errors, resp.Diagnostics)
+			}
+			if test.errors > 0 && resp.Diagnostics[0].Summary != "Missing Email" {
+				t.Errorf("unexpected error: %s", resp.Diagnostics[0].Summary)
+			}
+		})
+	}
+}
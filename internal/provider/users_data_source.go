@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/datasource_users"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/lakefsapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	dataSourceBase
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_users.UsersDataSourceSchema(ctx)
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_users.UsersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewAPIClient(d.client)
+
+	results, err := client.listUsers(ctx, lakefsapi.ListUsersParams{Prefix: optional(data.Prefix.ValueString())})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list users", err)
+		return
+	}
+
+	data.Id = types.StringValue("users")
+	data.Users = make([]datasource_users.UserModel, 0, len(results))
+	for _, user := range results {
+		groups, err := client.listUserGroups(ctx, user.ID, lakefsapi.ListUserGroupsParams{})
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("list groups of user %s", user.ID), err)
+			return
+		}
+
+		policies, err := client.listUserPolicies(ctx, user.ID, lakefsapi.ListUserPoliciesParams{})
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("list policies of user %s", user.ID), err)
+			return
+		}
+
+		effective, err := client.listUserPolicies(ctx, user.ID, lakefsapi.ListUserPoliciesParams{Effective: optional(true)})
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("list effective policies of user %s", user.ID), err)
+			return
+		}
+
+		model := datasource_users.UserModel{
+			Id:           types.StringValue(user.ID),
+			CreationDate: types.Int64Value(user.CreationDate),
+			Email:        optionalStringValue(user.Email),
+			FriendlyName: optionalStringValue(user.FriendlyName),
+		}
+		model.Groups = idListValue(ctx, groupIDs(groups), &resp.Diagnostics)
+		model.Policies = idListValue(ctx, policyIDs(policies), &resp.Diagnostics)
+		model.EffectivePolicies = idListValue(ctx, policyIDs(effective), &resp.Diagnostics)
+
+		data.Users = append(data.Users, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// userIDs returns the IDs of users
+func userIDs(users []lakefsapi.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	return ids
+}
+
+// groupIDs returns the IDs of groups
+func groupIDs(groups []lakefsapi.Group) []string {
+	ids := make([]string, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.ID)
+	}
+	return ids
+}
+
+// policyIDs returns the IDs of policies
+func policyIDs(policies []lakefsapi.Policy) []string {
+	ids := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		ids = append(ids, policy.ID)
+	}
+	return ids
+}
+
+// idListValue converts a list of IDs to a list attribute value
+func idListValue(ctx context.Context, ids []string, diags *diag.Diagnostics) types.List {
+	value, d := types.ListValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	return value
+}
@@ -7,9 +7,12 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider"
+	"github.com/Face-to-Face-IT/terraform-provider-lakefs/internal/provider/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -37,12 +40,33 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/Face-to-Face-IT/lakefs",
-		Debug:   debug,
+	ctx := context.Background()
+
+	server := providerserver.NewProtocol6(provider.New(version)())
+
+	// Tracing is optional, the provider works the same without it
+	shutdownTracing, err := tracing.Setup(ctx, version)
+	switch {
+	case err != nil:
+		log.Printf("[WARN] Unable to set up tracing: %s", err)
+		shutdownTracing = func(context.Context) error { return nil }
+	case tracing.Enabled():
+		server = tracing.WrapServer(server)
+	}
+
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err = tf6server.Serve("registry.terraform.io/Face-to-Face-IT/lakefs", server, opts...)
+
+	// Export the spans still buffered before exiting
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Printf("[WARN] Unable to export traces: %s", err)
+	}
+	cancel()
 
 	if err != nil {
 		log.Fatal(err.Error())